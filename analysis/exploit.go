@@ -0,0 +1,143 @@
+// Package analysis measures how predictable and exploitable strategies are.
+package analysis
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+// MaxMemory is the longest context, in rounds, a best response can condition on.
+const MaxMemory = 4
+
+// Config configures an exploitability measurement.
+type Config struct {
+	// Strategy is the spec of the measured strategy, as accepted by strategies.New.
+	Strategy string
+	// Memory is the longest context the best responses learn from; every length from 0 is tried.
+	Memory int
+	// TrainRounds are played before the measured rounds so the best response can learn.
+	TrainRounds int
+	Rounds      int
+	Seed        int64
+}
+
+// Exploit is the result of a best response that conditions on the last Memory rounds.
+type Exploit struct {
+	Memory int
+	// Rounds counts the measured rounds from the point of view of the best response.
+	Rounds simulation.Outcome
+}
+
+// PointsPerRound returns the rounds won minus the rounds lost by the best response, per round:
+// 1 for a fully predictable strategy, around 0 for one that cannot be exploited.
+func (r Exploit) PointsPerRound() float64 {
+	if r.Rounds.Total() == 0 {
+		return 0
+	}
+	return float64(r.Rounds.Wins-r.Rounds.Losses) / float64(r.Rounds.Total())
+}
+
+// Report holds a best response for every memory length from 0 to Config.Memory.
+type Report struct {
+	Config   Config
+	Exploits []Exploit
+}
+
+// Best returns the best response that exploits the strategy the most.
+func (r Report) Best() Exploit {
+	best := Exploit{}
+	for i, exploit := range r.Exploits {
+		if i == 0 || exploit.PointsPerRound() > best.PointsPerRound() {
+			best = exploit
+		}
+	}
+	return best
+}
+
+// Measure plays the strategy against learned best responses and reports how much each exploits it.
+// The strategy is seeded with the configured seed, so the measurement can be reproduced.
+func Measure(cfg Config) (Report, error) {
+	if cfg.Memory < 0 || cfg.Memory > MaxMemory {
+		return Report{}, fmt.Errorf("memory must be between 0 and %d", MaxMemory)
+	}
+	if cfg.TrainRounds < 0 || cfg.Rounds < 1 {
+		return Report{}, errors.New("rounds must be positive")
+	}
+
+	report := Report{Config: cfg}
+	for memory := 0; memory <= cfg.Memory; memory++ {
+		target, err := strategies.New(cfg.Strategy, random.NewPCG(uint64(cfg.Seed), 0))
+		if err != nil {
+			return Report{}, err
+		}
+		responder := newBestResponse(memory, random.NewPCG(uint64(cfg.Seed), 1))
+
+		exploit := Exploit{Memory: memory}
+		for round := 0; round < cfg.TrainRounds+cfg.Rounds; round++ {
+			own, opponent := responder.next(), target.Next()
+			responder.observe(own, opponent)
+			target.Observe(opponent, own)
+			if round < cfg.TrainRounds {
+				continue
+			}
+
+			switch {
+			case model.Beats(own, opponent):
+				exploit.Rounds.Wins++
+			case model.Beats(opponent, own):
+				exploit.Rounds.Losses++
+			default:
+				exploit.Rounds.Draws++
+			}
+		}
+		report.Exploits = append(report.Exploits, exploit)
+	}
+	return report, nil
+}
+
+// bestResponse counts the moves of the opponent after every context of the last rounds and
+// plays the counter of the most frequent one.
+type bestResponse struct {
+	random model.Randomizer
+	// counts holds the opponent moves seen after each context. A context encodes the last rounds
+	// as base-10 digits, 0 for a round not played yet and 1-9 for the pair of moves.
+	counts  [][3]int
+	context int
+}
+
+func newBestResponse(memory int, random model.Randomizer) *bestResponse {
+	size := 1
+	for i := 0; i < memory; i++ {
+		size *= 10
+	}
+	return &bestResponse{random: random, counts: make([][3]int, size)}
+}
+
+func (r *bestResponse) next() model.Move {
+	counts := r.counts[r.context]
+	best, ties := 0, 0
+	for i, count := range counts {
+		switch {
+		case count > counts[best]:
+			best, ties = i, 1
+		case count == counts[best]:
+			ties++
+			// keeps each of the tied moves with the same probability
+			if i != best && r.random.Intn(ties) == 0 {
+				best = i
+			}
+		}
+	}
+	return model.Counter(model.Moves[best])
+}
+
+func (r *bestResponse) observe(own, opponent model.Move) {
+	r.counts[r.context][opponent-1]++
+	pair := int(own-1)*3 + int(opponent-1) + 1
+	r.context = (r.context*10 + pair) % len(r.counts)
+}
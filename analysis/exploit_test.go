@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+func TestMeasure(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy string
+		memory   int
+		wantBest int
+		wantMin  float64
+		wantMax  float64
+	}{
+		{"constant move", "rock", 0, 0, 0.99, 1},
+		{"cycler needs one round of memory", "cycler", 1, 1, 0.99, 1},
+		{"computer heuristic", "computer", 1, 1, 0.99, 1},
+		{"uniform random", "biased:1,1,1", 1, -1, -0.05, 0.05},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Measure(Config{Strategy: tt.strategy, Memory: tt.memory, TrainRounds: 1000, Rounds: 10000, Seed: 1})
+			assert.NoError(t, err)
+			assert.Len(t, report.Exploits, tt.memory+1)
+
+			best := report.Best()
+			if tt.wantBest >= 0 {
+				assert.Equal(t, tt.wantBest, best.Memory)
+			}
+			assert.Equal(t, 10000, best.Rounds.Total())
+			assert.GreaterOrEqual(t, best.PointsPerRound(), tt.wantMin)
+			assert.LessOrEqual(t, best.PointsPerRound(), tt.wantMax)
+		})
+	}
+}
+
+func TestMeasure_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"memory too long", Config{Strategy: "rock", Memory: MaxMemory + 1, Rounds: 10}},
+		{"no rounds", Config{Strategy: "rock", Rounds: 0}},
+		{"unknown strategy", Config{Strategy: "lizard", Rounds: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Measure(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExploit_PointsPerRound(t *testing.T) {
+	assert.Equal(t, 0.0, Exploit{}.PointsPerRound())
+	assert.Equal(t, 0.25, Exploit{Rounds: simulation.Outcome{Wins: 2, Draws: 1, Losses: 1}}.PointsPerRound())
+}
@@ -0,0 +1,107 @@
+package analysis
+
+import (
+	"math"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+// MaxEntropy is the entropy, in bits, of a player choosing each move with the same probability.
+var MaxEntropy = math.Log2(3)
+
+// Predictability measures how predictable a human player was in their recorded matches.
+type Predictability struct {
+	// Profile counts the moves, the moves after each last round and the shifts of the player.
+	Profile *opponents.Model
+	// Markov counts, from the point of view of the predictor, the rounds a Markov predictor that
+	// counters the most frequent move after the last round would have won, drawn and lost.
+	Markov simulation.Outcome
+}
+
+// MeasurePredictability replays the rounds of the player in the matches, oldest first.
+func MeasurePredictability(matches []model.Match, player string) Predictability {
+	r := Predictability{Profile: opponents.NewModel(player)}
+	for _, match := range matches {
+		side := 0
+		switch player {
+		case match.Players[0]:
+		case match.Players[1]:
+			side = 1
+		default:
+			continue
+		}
+		r.Profile.NewGame()
+		for _, round := range match.Rounds {
+			own, opponent := round.Moves[side], round.Moves[1-side]
+			if !own.Valid() || !opponent.Valid() {
+				continue
+			}
+			// the predictor only knows the rounds before this one
+			if prediction, _ := r.Profile.Predict(); prediction.Move.Valid() {
+				switch counter := model.Counter(prediction.Move); {
+				case model.Beats(counter, own):
+					r.Markov.Wins++
+				case model.Beats(own, counter):
+					r.Markov.Losses++
+				default:
+					r.Markov.Draws++
+				}
+			}
+			r.Profile.Observe(own, opponent)
+		}
+	}
+	return r
+}
+
+// Entropy returns the Shannon entropy of the moves of the player, in bits: MaxEntropy for a player
+// choosing at random, 0 for one always playing the same move.
+func (r Predictability) Entropy() float64 {
+	return entropy(r.Profile.Moves[:])
+}
+
+// ConditionalEntropy returns the entropy of the moves that follow a round given the moves of that
+// round, in bits. The difference with Entropy is what the last round tells about the next move.
+func (r Predictability) ConditionalEntropy() float64 {
+	total := 0
+	for _, counts := range r.Profile.Transitions {
+		total += counts[0] + counts[1] + counts[2]
+	}
+	if total == 0 {
+		return 0
+	}
+	conditional := 0.0
+	for _, counts := range r.Profile.Transitions {
+		weight := float64(counts[0]+counts[1]+counts[2]) / float64(total)
+		conditional += weight * entropy(counts[:])
+	}
+	return conditional
+}
+
+// ShiftRate returns how often the player made the shift after the outcome, see opponents.ShiftStay,
+// and the number of rounds that followed the outcome.
+func (r Predictability) ShiftRate(outcome, shift int) (float64, int) {
+	counts := r.Profile.Shifts[outcome]
+	total := counts[0] + counts[1] + counts[2]
+	if total == 0 {
+		return 0, 0
+	}
+	return float64(counts[shift]) / float64(total), total
+}
+
+// entropy returns the Shannon entropy, in bits, of the distribution of the counts.
+func entropy(counts []int) float64 {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	h := 0.0
+	for _, count := range counts {
+		if count > 0 {
+			p := float64(count) / float64(total)
+			h -= p * math.Log2(p)
+		}
+	}
+	return h
+}
@@ -0,0 +1,81 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+// predictabilityMatch is a match of ANA, second player, against ROBOT.
+func predictabilityMatch(ana, robot []model.Move) model.Match {
+	match := model.Match{Players: [2]string{"ROBOT", "ANA"}}
+	for i := range ana {
+		match.Rounds = append(match.Rounds, model.MatchRound{Moves: [2]model.Move{robot[i], ana[i]}})
+	}
+	return match
+}
+
+func repeat(moves []model.Move, n int) []model.Move {
+	var repeated []model.Move
+	for len(repeated) < n {
+		repeated = append(repeated, moves...)
+	}
+	return repeated[:n]
+}
+
+func TestMeasurePredictability(t *testing.T) {
+	tests := []struct {
+		name                   string
+		ana, robot             []model.Move
+		wantEntropy            float64
+		wantConditionalEntropy float64
+		wantWinStay            float64
+		wantMarkov             simulation.Outcome
+	}{
+		{
+			name:  "always rock",
+			ana:   repeat([]model.Move{model.Rock}, 10),
+			robot: repeat([]model.Move{model.Scissors}, 10),
+			// the predictor needs 5 rounds after the same last round before it predicts
+			wantWinStay: 1,
+			wantMarkov:  simulation.Outcome{Wins: 4},
+		},
+		{
+			name:        "cycles against a rock",
+			ana:         repeat([]model.Move{model.Rock, model.Paper, model.Scissors}, 30),
+			robot:       repeat([]model.Move{model.Rock}, 30),
+			wantEntropy: MaxEntropy,
+			wantWinStay: 0,
+			// each of the three last rounds needs 5 samples, so 29 - 15 rounds are predicted
+			wantMarkov: simulation.Outcome{Wins: 14},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := []model.Match{
+				predictabilityMatch(tt.ana, tt.robot),
+				{Players: [2]string{"PAUL", "ROBOT"}, Rounds: []model.MatchRound{{Moves: [2]model.Move{model.Paper, model.Rock}}}},
+			}
+			report := MeasurePredictability(matches, "ANA")
+			assert.Equal(t, len(tt.ana), report.Profile.Rounds())
+			assert.InDelta(t, tt.wantEntropy, report.Entropy(), 1e-9)
+			assert.InDelta(t, tt.wantConditionalEntropy, report.ConditionalEntropy(), 1e-9)
+			winStay, _ := report.ShiftRate(opponents.OutcomeWin, opponents.ShiftStay)
+			assert.InDelta(t, tt.wantWinStay, winStay, 1e-9)
+			assert.Equal(t, tt.wantMarkov, report.Markov)
+		})
+	}
+}
+
+func TestMeasurePredictability_noRounds(t *testing.T) {
+	report := MeasurePredictability(nil, "ANA")
+	assert.Equal(t, 0.0, report.Entropy())
+	assert.Equal(t, 0.0, report.ConditionalEntropy())
+	rate, rounds := report.ShiftRate(opponents.OutcomeLoss, opponents.ShiftUp)
+	assert.Equal(t, 0.0, rate)
+	assert.Equal(t, 0, rounds)
+}
@@ -0,0 +1,145 @@
+package analysis
+
+import (
+	"math"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Alpha is the significance level of the randomness tests: a fair source fails each test 1% of the time.
+const Alpha = 0.01
+
+// RandomnessTest is the result of a statistical test of a sequence of moves.
+type RandomnessTest struct {
+	Name        string
+	Description string
+	Statistic   float64
+	// PValue is the probability of a statistic at least as extreme from a fair source.
+	PValue float64
+}
+
+// Passed reports whether the sequence is compatible with a fair source at the Alpha level.
+func (r RandomnessTest) Passed() bool {
+	return r.PValue >= Alpha
+}
+
+// CheckRandomness tests whether the moves look drawn uniformly and independently.
+func CheckRandomness(moves []model.Move) []RandomnessTest {
+	return []RandomnessTest{
+		uniformity(moves),
+		pairs(moves),
+		serialCorrelation(moves),
+		runs(moves),
+	}
+}
+
+// uniformity is a chi-square test of the move frequencies against 1/3 each.
+func uniformity(moves []model.Move) RandomnessTest {
+	counts := make([]int, len(model.Moves))
+	for _, move := range moves {
+		counts[move-1]++
+	}
+	statistic := chiSquare(counts)
+	return RandomnessTest{
+		Name:        "uniformity",
+		Description: "chi-square of the move frequencies",
+		Statistic:   statistic,
+		PValue:      chiSquareSurvival(statistic, len(counts)-1),
+	}
+}
+
+// pairs is a chi-square test of the frequencies of non-overlapping pairs of consecutive moves,
+// which catches a move depending on the previous one.
+func pairs(moves []model.Move) RandomnessTest {
+	counts := make([]int, len(model.Moves)*len(model.Moves))
+	for i := 1; i < len(moves); i += 2 {
+		counts[int(moves[i-1]-1)*len(model.Moves)+int(moves[i]-1)]++
+	}
+	statistic := chiSquare(counts)
+	return RandomnessTest{
+		Name:        "serial pairs",
+		Description: "chi-square of the pairs of consecutive moves",
+		Statistic:   statistic,
+		PValue:      chiSquareSurvival(statistic, len(counts)-1),
+	}
+}
+
+// serialCorrelation tests the lag-1 autocorrelation of the moves, normally distributed with a
+// standard deviation of 1/sqrt(n) for a fair source.
+func serialCorrelation(moves []model.Move) RandomnessTest {
+	n := float64(len(moves))
+	mean := 0.0
+	for _, move := range moves {
+		mean += float64(move)
+	}
+	mean /= n
+	covariance, variance := 0.0, 0.0
+	for i, move := range moves {
+		deviation := float64(move) - mean
+		variance += deviation * deviation
+		if i > 0 {
+			covariance += deviation * (float64(moves[i-1]) - mean)
+		}
+	}
+	correlation := 0.0
+	if variance > 0 {
+		correlation = covariance / variance
+	}
+	return RandomnessTest{
+		Name:        "serial correlation",
+		Description: "lag-1 autocorrelation of the moves",
+		Statistic:   correlation,
+		PValue:      normalTwoSided(correlation * math.Sqrt(n)),
+	}
+}
+
+// runs compares the number of runs of identical moves with the 1 + 2(n-1)/3 expected from a fair
+// source, whose variance is 2(n-1)/9 as consecutive changes are independent.
+func runs(moves []model.Move) RandomnessTest {
+	count := 1
+	for i := 1; i < len(moves); i++ {
+		if moves[i] != moves[i-1] {
+			count++
+		}
+	}
+	n := float64(len(moves))
+	expected := 1 + 2*(n-1)/3
+	z := (float64(count) - expected) / math.Sqrt(2*(n-1)/9)
+	return RandomnessTest{
+		Name:        "runs",
+		Description: "number of runs of identical moves, as a z-score",
+		Statistic:   z,
+		PValue:      normalTwoSided(z),
+	}
+}
+
+// chiSquare returns the chi-square statistic of counts against equal expected counts.
+func chiSquare(counts []int) float64 {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	expected := float64(total) / float64(len(counts))
+	statistic := 0.0
+	for _, count := range counts {
+		statistic += (float64(count) - expected) * (float64(count) - expected) / expected
+	}
+	return statistic
+}
+
+// chiSquareSurvival returns P(X >= x) for a chi-square distribution with an even number of degrees of
+// freedom, which has the closed form exp(-x/2) * sum of (x/2)^k/k! for k below df/2.
+func chiSquareSurvival(x float64, df int) float64 {
+	half := x / 2
+	term, sum := 1.0, 1.0
+	for k := 1; k < df/2; k++ {
+		term *= half / float64(k)
+		sum += term
+	}
+	return math.Min(1, math.Exp(-half)*sum)
+}
+
+// normalTwoSided returns P(|Z| >= |z|) for a standard normal Z.
+func normalTwoSided(z float64) float64 {
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
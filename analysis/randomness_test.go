@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+)
+
+func TestCheckRandomness(t *testing.T) {
+	draw := func(n int, next func(i int) model.Move) []model.Move {
+		moves := make([]model.Move, n)
+		for i := range moves {
+			moves[i] = next(i)
+		}
+		return moves
+	}
+	fair := random.NewPCG(1, 1)
+	biased := random.NewPCG(1, 2)
+	sticky := random.NewPCG(1, 3)
+	previous := model.Rock
+
+	tests := []struct {
+		name       string
+		moves      []model.Move
+		wantPassed []string
+		wantFailed []string
+	}{
+		{
+			name:       "fair source",
+			moves:      draw(30000, func(int) model.Move { return model.Move(fair.Intn(3) + 1) }),
+			wantPassed: []string{"uniformity", "serial pairs", "serial correlation", "runs"},
+		},
+		{
+			name:       "cycle is uniform but predictable",
+			moves:      draw(30000, func(i int) model.Move { return model.Moves[i%3] }),
+			wantPassed: []string{"uniformity"},
+			wantFailed: []string{"serial pairs", "serial correlation", "runs"},
+		},
+		{
+			name: "biased towards rock",
+			moves: draw(30000, func(int) model.Move {
+				if biased.Intn(10) == 0 {
+					return model.Rock
+				}
+				return model.Move(biased.Intn(3) + 1)
+			}),
+			wantFailed: []string{"uniformity"},
+		},
+		{
+			name: "repeats the previous move too often",
+			moves: draw(30000, func(int) model.Move {
+				if sticky.Intn(10) != 0 {
+					previous = model.Move(sticky.Intn(3) + 1)
+				}
+				return previous
+			}),
+			wantPassed: []string{"uniformity"},
+			wantFailed: []string{"serial pairs", "serial correlation", "runs"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passed := map[string]bool{}
+			for _, result := range CheckRandomness(tt.moves) {
+				passed[result.Name] = result.Passed()
+			}
+			for _, name := range tt.wantPassed {
+				assert.True(t, passed[name], "%s should pass", name)
+			}
+			for _, name := range tt.wantFailed {
+				assert.False(t, passed[name], "%s should fail", name)
+			}
+		})
+	}
+}
+
+func Test_chiSquareSurvival(t *testing.T) {
+	// critical values of the 1% level
+	assert.InDelta(t, 0.01, chiSquareSurvival(9.2103, 2), 1e-4)
+	assert.InDelta(t, 0.01, chiSquareSurvival(20.0902, 8), 1e-4)
+	assert.Equal(t, 1.0, chiSquareSurvival(0, 8))
+}
+
+func Test_normalTwoSided(t *testing.T) {
+	assert.InDelta(t, 0.05, normalTwoSided(1.959964), 1e-6)
+	assert.InDelta(t, 0.05, normalTwoSided(-1.959964), 1e-6)
+}
@@ -0,0 +1,158 @@
+package botproto
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Check names, in the order they run.
+const (
+	CheckHandshake = "handshake"
+	CheckGame      = "game"
+	CheckRematch   = "rematch"
+	CheckQuit      = "quit"
+	CheckRestart   = "restart"
+)
+
+// CheckConfig configures a conformance run of a bot.
+type CheckConfig struct {
+	Command []string
+	Timeout time.Duration
+	Rounds  int
+	Stderr  io.Writer
+}
+
+// CheckResult is the outcome of one conformance check.
+type CheckResult struct {
+	Name    string
+	Passed  bool
+	Skipped bool
+	Detail  string
+}
+
+// Passed reports whether every check of the report passed.
+func Passed(results []CheckResult) bool {
+	for _, result := range results {
+		if !result.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Check runs the bot through a full session and reports which parts of the protocol it follows.
+// Checks that depend on a failed one are reported as skipped.
+func Check(cfg CheckConfig) []CheckResult {
+	results := make([]CheckResult, 0, 5)
+	skip := func(names ...string) []CheckResult {
+		for _, name := range names {
+			results = append(results, CheckResult{Name: name, Skipped: true, Detail: "an earlier check failed"})
+		}
+		return results
+	}
+
+	conn, err := Start(cfg.Command, cfg.Stderr)
+	if err != nil {
+		results = append(results, failed(CheckHandshake, err))
+		return skip(CheckGame, CheckRematch, CheckQuit, CheckRestart)
+	}
+	defer func() { _ = conn.Close() }()
+
+	name, err := conn.Handshake(cfg.Timeout)
+	if err != nil {
+		results = append(results, failed(CheckHandshake, err))
+		return skip(CheckGame, CheckRematch, CheckQuit, CheckRestart)
+	}
+	results = append(results, passed(CheckHandshake, "bot introduced itself as %q", name))
+
+	slowest, err := checkGame(conn, cfg.Rounds, cfg.Timeout)
+	if err != nil {
+		results = append(results, failed(CheckGame, err))
+		return skip(CheckRematch, CheckQuit, CheckRestart)
+	}
+	results = append(results, passed(CheckGame, "%d legal moves, slowest answer took %v", cfg.Rounds, slowest))
+
+	results = append(results, checkRematch(conn, cfg.Timeout))
+
+	if err = conn.Quit(); err != nil {
+		results = append(results, failed(CheckQuit, err))
+	} else {
+		results = append(results, passed(CheckQuit, "bot exited by itself"))
+	}
+
+	results = append(results, checkRestart(cfg))
+	return results
+}
+
+// checkGame plays the given number of rounds, cycling the opponent moves, and returns the slowest answer time.
+func checkGame(conn *Conn, rounds int, timeout time.Duration) (time.Duration, error) {
+	var slowest time.Duration
+	history := make([]Round, 0, rounds)
+	for i := 0; i < rounds; i++ {
+		start := time.Now()
+		move, err := conn.RequestMove(i+1, history, timeout)
+		if err != nil {
+			return 0, fmt.Errorf("round %d: %w", i+1, err)
+		}
+		if elapsed := time.Since(start); elapsed > slowest {
+			slowest = elapsed
+		}
+
+		opponent := model.Moves[i%len(model.Moves)]
+		played := NewRound(move, opponent)
+		history = append(history, played)
+		err = conn.Send(Message{
+			Type:     TypeResult,
+			Round:    i + 1,
+			You:      played.You,
+			Opponent: played.Opponent,
+			Outcome:  played.Outcome,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("round %d: %w", i+1, err)
+		}
+	}
+	return slowest, nil
+}
+
+// checkRematch starts a new game on the same connection and plays its first round.
+func checkRematch(conn *Conn, timeout time.Duration) CheckResult {
+	if err := conn.Send(Message{Type: TypeNewGame}); err != nil {
+		return failed(CheckRematch, err)
+	}
+	if _, err := conn.Expect(TypeReady, timeout); err != nil {
+		return failed(CheckRematch, err)
+	}
+	if _, err := conn.RequestMove(1, nil, timeout); err != nil {
+		return failed(CheckRematch, fmt.Errorf("first round: %w", err))
+	}
+	return passed(CheckRematch, "new game acknowledged")
+}
+
+// checkRestart launches the bot a second time, as tournaments do between matches.
+func checkRestart(cfg CheckConfig) CheckResult {
+	conn, err := Start(cfg.Command, cfg.Stderr)
+	if err != nil {
+		return failed(CheckRestart, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err = conn.Handshake(cfg.Timeout); err != nil {
+		return failed(CheckRestart, err)
+	}
+	if _, err = conn.RequestMove(1, nil, cfg.Timeout); err != nil {
+		return failed(CheckRestart, fmt.Errorf("first round: %w", err))
+	}
+	return passed(CheckRestart, "second process answered")
+}
+
+func passed(name, format string, args ...any) CheckResult {
+	return CheckResult{Name: name, Passed: true, Detail: fmt.Sprintf(format, args...)}
+}
+
+func failed(name string, err error) CheckResult {
+	return CheckResult{Name: name, Detail: err.Error()}
+}
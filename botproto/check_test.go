@@ -0,0 +1,76 @@
+package botproto
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		behaviour  string
+		wantPassed []bool // handshake, game, rematch, quit, restart
+		wantDetail string
+	}{
+		{
+			name:       "conforming bot passes every check",
+			behaviour:  "rock",
+			wantPassed: []bool{true, true, true, true, true},
+			wantDetail: "5 legal moves",
+		},
+		{
+			name:       "bot writing malformed json fails the handshake",
+			behaviour:  "garbage",
+			wantPassed: []bool{false, false, false, false, false},
+			wantDetail: "malformed message",
+		},
+		{
+			name:       "bot that never answers times out",
+			behaviour:  "silent",
+			wantPassed: []bool{false, false, false, false, false},
+			wantDetail: "did not answer in time",
+		},
+		{
+			name:       "bot playing illegal moves fails the game",
+			behaviour:  "illegal",
+			wantPassed: []bool{true, false, false, false, false},
+			wantDetail: "illegal move",
+		},
+		{
+			name:       "bot ignoring new games fails the rematch",
+			behaviour:  "no-rematch",
+			wantPassed: []bool{true, true, false, true, true},
+			wantDetail: "did not answer in time",
+		},
+		{
+			name:       "bot ignoring quit is killed",
+			behaviour:  "stubborn",
+			wantPassed: []bool{true, true, true, false, true},
+			wantDetail: "ignored quit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(helperEnv, tt.behaviour)
+			results := Check(CheckConfig{
+				Command: []string{os.Args[0], "-test.run=^TestHelperBot$"},
+				Timeout: 300 * time.Millisecond,
+				Rounds:  5,
+			})
+
+			gotPassed := make([]bool, 0, len(results))
+			details := ""
+			for _, result := range results {
+				gotPassed = append(gotPassed, result.Passed)
+				details += result.Detail + "\n"
+			}
+			assert.Equal(t, tt.wantPassed, gotPassed)
+			assert.Contains(t, details, tt.wantDetail)
+			assert.Equal(t, !slices.Contains(tt.wantPassed, false), Passed(results))
+		})
+	}
+}
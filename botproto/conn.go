@@ -0,0 +1,211 @@
+package botproto
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+var (
+	// ErrTimeout is returned when the bot does not answer in time.
+	ErrTimeout = errors.New("bot did not answer in time")
+	// ErrCrashed is returned when the bot process exits or closes its output.
+	ErrCrashed = errors.New("bot process exited")
+	// ErrMalformed is returned when the bot writes a line that is not a valid protocol message.
+	ErrMalformed = errors.New("malformed message")
+	// ErrUnexpected is returned when the bot answers with the wrong message type.
+	ErrUnexpected = errors.New("unexpected message")
+	// ErrIllegalMove is returned when the bot plays a move outside the ruleset.
+	ErrIllegalMove = errors.New("illegal move")
+)
+
+// quitGrace is how long a bot has to exit by itself after the quit message.
+const quitGrace = time.Second
+
+// Conn is the engine side of a connection to a bot child process.
+type Conn struct {
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	lines   chan string
+	closing chan struct{}
+	exited  chan struct{}
+	err     error
+}
+
+// Start launches the bot command and starts reading its output.
+// The bot stderr is copied to the given writer, which may be nil to discard it.
+func Start(command []string, stderr io.Writer) (*Conn, error) {
+	if len(command) == 0 {
+		return nil, errors.New("empty bot command")
+	}
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stderr = stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting bot: %w", err)
+	}
+
+	r := &Conn{
+		cmd:     cmd,
+		stdin:   stdin,
+		lines:   make(chan string),
+		closing: make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+	go r.read(stdout)
+	return r, nil
+}
+
+// read forwards every output line of the bot until it closes its output, then waits for the process.
+// Once the connection is closing, the remaining lines are discarded.
+func (r *Conn) read(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		select {
+		case r.lines <- scanner.Text():
+		case <-r.closing:
+		}
+	}
+	r.err = r.cmd.Wait()
+	close(r.exited)
+}
+
+// Send writes a message to the bot.
+func (r *Conn) Send(msg Message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err = r.stdin.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("%w: %v", ErrCrashed, err)
+	}
+	return nil
+}
+
+// Receive waits for the next message of the bot.
+func (r *Conn) Receive(timeout time.Duration) (Message, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case line := <-r.lines:
+		msg := Message{}
+		if err := json.Unmarshal([]byte(line), &msg); err != nil || msg.Type == "" {
+			return Message{}, fmt.Errorf("%w: %q", ErrMalformed, line)
+		}
+		return msg, nil
+	case <-r.exited:
+		if r.err != nil {
+			return Message{}, fmt.Errorf("%w: %v", ErrCrashed, r.err)
+		}
+		return Message{}, ErrCrashed
+	case <-timer.C:
+		return Message{}, fmt.Errorf("%w (%v)", ErrTimeout, timeout)
+	}
+}
+
+// Expect waits for a message of the given type.
+func (r *Conn) Expect(msgType string, timeout time.Duration) (Message, error) {
+	msg, err := r.Receive(timeout)
+	if err != nil {
+		return Message{}, err
+	}
+	if msg.Type != msgType {
+		return Message{}, fmt.Errorf("%w: got %q, want %q", ErrUnexpected, msg.Type, msgType)
+	}
+	return msg, nil
+}
+
+// Handshake greets the bot with the classic ruleset and returns the name it announces.
+func (r *Conn) Handshake(timeout time.Duration) (string, error) {
+	err := r.Send(Message{
+		Type:      TypeHello,
+		Version:   Version,
+		Ruleset:   model.RulesetClassic,
+		Moves:     MoveNames(),
+		TimeoutMs: timeout.Milliseconds(),
+	})
+	if err != nil {
+		return "", err
+	}
+	msg, err := r.Expect(TypeReady, timeout)
+	if err != nil {
+		return "", err
+	}
+	return msg.Name, nil
+}
+
+// RequestMove asks the bot for its next move and validates the answer.
+func (r *Conn) RequestMove(round int, history []Round, timeout time.Duration) (model.Move, error) {
+	if err := r.Send(Message{Type: TypePlay, Round: round, History: history}); err != nil {
+		return 0, err
+	}
+	msg, err := r.Expect(TypeMove, timeout)
+	if err != nil {
+		return 0, err
+	}
+	move, err := model.ParseMove(msg.Move)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrIllegalMove, err)
+	}
+	return move, nil
+}
+
+// Exited reports whether the bot process has finished.
+func (r *Conn) Exited() bool {
+	select {
+	case <-r.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+// Quit asks the bot to quit and kills it if it does not exit in time, in which case ErrTimeout is returned.
+func (r *Conn) Quit() error {
+	select {
+	case <-r.closing:
+		return nil
+	default:
+		close(r.closing)
+	}
+	if r.Exited() {
+		return nil
+	}
+	_ = r.Send(Message{Type: TypeQuit})
+	_ = r.stdin.Close()
+
+	timer := time.NewTimer(quitGrace)
+	defer timer.Stop()
+	select {
+	case <-r.exited:
+		return nil
+	case <-timer.C:
+		if err := r.cmd.Process.Kill(); err != nil {
+			return err
+		}
+		<-r.exited
+		return fmt.Errorf("%w: the bot ignored quit and was killed", ErrTimeout)
+	}
+}
+
+// Close ends the bot process, killing it if needed.
+func (r *Conn) Close() error {
+	if err := r.Quit(); err != nil && !errors.Is(err, ErrTimeout) {
+		return err
+	}
+	return nil
+}
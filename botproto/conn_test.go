@@ -0,0 +1,171 @@
+package botproto
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+const helperEnv = "RPS_TEST_BOT"
+
+// TestHelperBot is not a real test: it is the bot process launched by the other tests.
+func TestHelperBot(t *testing.T) {
+	behaviour := os.Getenv(helperEnv)
+	if behaviour == "" {
+		return
+	}
+	switch behaviour {
+	case "rock":
+		_ = Serve(os.Stdin, os.Stdout, "Rocky", func([]Round) string { return "rock" })
+	case "illegal":
+		_ = Serve(os.Stdin, os.Stdout, "Lizzy", func([]Round) string { return "lizard" })
+	case "garbage":
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			fmt.Println("this is not json")
+		}
+	case "stubborn":
+		serveIgnoring(TypeQuit)
+		time.Sleep(10 * time.Second)
+	case "no-rematch":
+		serveIgnoring(TypeNewGame)
+	case "silent":
+		time.Sleep(10 * time.Second)
+	case "crash":
+		os.Exit(3)
+	}
+	os.Exit(0)
+}
+
+// serveIgnoring answers like Serve with rock, except for the ignored message type.
+func serveIgnoring(ignored string) {
+	scanner := bufio.NewScanner(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+	for scanner.Scan() {
+		msg := Message{}
+		_ = json.Unmarshal(scanner.Bytes(), &msg)
+		switch {
+		case msg.Type == ignored:
+		case msg.Type == TypeHello || msg.Type == TypeNewGame:
+			_ = encoder.Encode(Message{Type: TypeReady, Name: "Stubborn"})
+		case msg.Type == TypePlay:
+			_ = encoder.Encode(Message{Type: TypeMove, Move: "rock"})
+		case msg.Type == TypeQuit:
+			return
+		}
+	}
+}
+
+func startHelperBot(t *testing.T, behaviour string) *Conn {
+	t.Setenv(helperEnv, behaviour)
+	conn, err := Start([]string{os.Args[0], "-test.run=^TestHelperBot$"}, nil)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestConn_Handshake(t *testing.T) {
+	tests := []struct {
+		name      string
+		behaviour string
+		wantName  string
+		wantErr   error
+	}{
+		{"well behaved bot", "rock", "Rocky", nil},
+		{"bot writes malformed json", "garbage", "", ErrMalformed},
+		{"bot never answers", "silent", "", ErrTimeout},
+		{"bot crashes", "crash", "", ErrCrashed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := startHelperBot(t, tt.behaviour)
+			name, err := conn.Handshake(500 * time.Millisecond)
+			assert.Equal(t, tt.wantName, name)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, tt.wantErr), "got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConn_RequestMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		behaviour string
+		wantMove  model.Move
+		wantErr   bool
+	}{
+		{"legal move", "rock", model.Rock, false},
+		{"illegal move", "illegal", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := startHelperBot(t, tt.behaviour)
+			_, err := conn.Handshake(time.Second)
+			assert.NoError(t, err)
+
+			move, err := conn.RequestMove(1, nil, time.Second)
+			assert.Equal(t, tt.wantMove, move)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestConn_Close(t *testing.T) {
+	conn := startHelperBot(t, "silent")
+	start := time.Now()
+	assert.NoError(t, conn.Close())
+	assert.True(t, conn.Exited())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestConn_Quit(t *testing.T) {
+	tests := []struct {
+		name      string
+		behaviour string
+		wantErr   error
+	}{
+		{"bot exits when asked", "rock", nil},
+		{"bot ignores quit and is killed", "silent", ErrTimeout},
+		{"bot already exited", "crash", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := startHelperBot(t, tt.behaviour)
+			if tt.behaviour == "crash" {
+				_, _ = conn.Receive(time.Second)
+			}
+			err := conn.Quit()
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v, want %v", err, tt.wantErr)
+			assert.True(t, conn.Exited())
+		})
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		name          string
+		own, opponent model.Move
+		want          string
+	}{
+		{"win", model.Paper, model.Rock, OutcomeWin},
+		{"loss", model.Paper, model.Scissors, OutcomeLoss},
+		{"draw", model.Paper, model.Paper, OutcomeDraw},
+		{"opponent forfeited", model.Paper, 0, OutcomeWin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Outcome(tt.own, tt.opponent))
+		})
+	}
+}
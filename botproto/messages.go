@@ -0,0 +1,97 @@
+// Package botproto implements the line-based JSON protocol used to play against external bots.
+//
+// Every message is a single JSON object terminated by a newline. A session looks like:
+//
+//	engine -> bot: {"type":"hello","version":1,"ruleset":"classic","moves":["rock","paper","scissors"],"timeout_ms":2000}
+//	bot -> engine: {"type":"ready","name":"MyBot"}
+//	engine -> bot: {"type":"play","round":1,"history":[]}
+//	bot -> engine: {"type":"move","move":"rock"}
+//	engine -> bot: {"type":"result","round":1,"you":"rock","opponent":"paper","outcome":"loss"}
+//	engine -> bot: {"type":"new_game"}
+//	bot -> engine: {"type":"ready","name":"MyBot"}
+//	engine -> bot: {"type":"quit"}
+package botproto
+
+import (
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Version is the protocol version sent in the handshake.
+const Version = 1
+
+// Message types.
+const (
+	TypeHello   = "hello"
+	TypeReady   = "ready"
+	TypePlay    = "play"
+	TypeMove    = "move"
+	TypeResult  = "result"
+	TypeNewGame = "new_game"
+	TypeQuit    = "quit"
+)
+
+// Round outcomes, from the point of view of the bot.
+const (
+	OutcomeWin  = "win"
+	OutcomeDraw = "draw"
+	OutcomeLoss = "loss"
+)
+
+// Message is the envelope of every protocol line; only the fields of its type are set.
+type Message struct {
+	Type      string   `json:"type"`
+	Version   int      `json:"version,omitempty"`
+	Ruleset   string   `json:"ruleset,omitempty"`
+	Moves     []string `json:"moves,omitempty"`
+	TimeoutMs int64    `json:"timeout_ms,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Round     int      `json:"round,omitempty"`
+	History   []Round  `json:"history,omitempty"`
+	Move      string   `json:"move,omitempty"`
+	You       string   `json:"you,omitempty"`
+	Opponent  string   `json:"opponent,omitempty"`
+	Outcome   string   `json:"outcome,omitempty"`
+}
+
+// Round is a finished round as seen by the bot.
+type Round struct {
+	You      string `json:"you"`
+	Opponent string `json:"opponent"`
+	Outcome  string `json:"outcome"`
+}
+
+// MoveName returns the protocol name of a move, or an empty string when there was no move.
+func MoveName(m model.Move) string {
+	return strings.ToLower(model.MoveToStr[m])
+}
+
+// MoveNames returns the protocol names of the ruleset moves.
+func MoveNames() []string {
+	names := make([]string, 0, len(model.Moves))
+	for _, m := range model.Moves {
+		names = append(names, MoveName(m))
+	}
+	return names
+}
+
+// Outcome returns the outcome of a round for the player that threw own.
+func Outcome(own, opponent model.Move) string {
+	switch {
+	case model.Beats(own, opponent):
+		return OutcomeWin
+	case model.Beats(opponent, own):
+		return OutcomeLoss
+	}
+	return OutcomeDraw
+}
+
+// NewRound builds the history entry of a finished round.
+func NewRound(own, opponent model.Move) Round {
+	return Round{
+		You:      MoveName(own),
+		Opponent: MoveName(opponent),
+		Outcome:  Outcome(own, opponent),
+	}
+}
@@ -0,0 +1,38 @@
+package botproto
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// MoveFunc chooses the next move, by its protocol name, from the rounds played so far in the game.
+type MoveFunc func(history []Round) string
+
+// Serve runs the bot side of the protocol until the engine quits or closes the input.
+// It lets bots written in Go answer the engine without handling the protocol themselves.
+func Serve(in io.Reader, out io.Writer, name string, next MoveFunc) error {
+	scanner := bufio.NewScanner(in)
+	encoder := json.NewEncoder(out)
+	for scanner.Scan() {
+		msg := Message{}
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			return fmt.Errorf("%w: %q", ErrMalformed, scanner.Text())
+		}
+
+		var err error
+		switch msg.Type {
+		case TypeHello, TypeNewGame:
+			err = encoder.Encode(Message{Type: TypeReady, Name: name})
+		case TypePlay:
+			err = encoder.Encode(Message{Type: TypeMove, Move: next(msg.History)})
+		case TypeQuit:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,54 @@
+package botproto
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServe(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "full session",
+			input: `{"type":"hello","version":1}` + "\n" +
+				`{"type":"play","round":1}` + "\n" +
+				`{"type":"result","round":1,"you":"paper","opponent":"rock","outcome":"win"}` + "\n" +
+				`{"type":"play","round":2,"history":[{"you":"paper","opponent":"rock","outcome":"win"}]}` + "\n" +
+				`{"type":"new_game"}` + "\n" +
+				`{"type":"quit"}` + "\n" +
+				`{"type":"play","round":1}` + "\n",
+			want: `{"type":"ready","name":"ECHO"}` + "\n" +
+				`{"type":"move","move":"paper"}` + "\n" +
+				`{"type":"move","move":"rock"}` + "\n" +
+				`{"type":"ready","name":"ECHO"}` + "\n",
+		},
+		{
+			name:    "malformed input",
+			input:   "hello\n",
+			want:    "",
+			wantErr: ErrMalformed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			// plays paper, then whatever the opponent played last
+			err := Serve(strings.NewReader(tt.input), out, "ECHO", func(history []Round) string {
+				if len(history) == 0 {
+					return "paper"
+				}
+				return history[len(history)-1].Opponent
+			})
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v, want %v", err, tt.wantErr)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
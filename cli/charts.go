@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// defaultChartWidth is used when the output is not a terminal.
+	defaultChartWidth = 80
+	// maxCellWidth keeps the heatmap compact on wide terminals.
+	maxCellWidth = 12
+	resetColors  = "\u001B[0m"
+)
+
+// heatColors are ANSI 256 background colors from the coldest to the hottest share.
+var heatColors = []int{230, 229, 228, 221, 214, 208, 202, 196}
+
+// sparks are the bars of a sparkline, from the lowest to the highest value.
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// chartWidth returns the width available to the charts.
+func chartWidth() int {
+	width, err := screenWidthSingleton()
+	if err != nil || width <= 0 {
+		return defaultChartWidth
+	}
+	return width
+}
+
+// DisplayHeatmap draws the counts of each row label followed by each column label, e.g. the
+// previous move followed by the next one, as shares of the row on a colored background.
+func DisplayHeatmap(rowTitle string, labels []string, counts [][]int) {
+	labelWidth := utf8.RuneCountInString(rowTitle)
+	for _, label := range labels {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(label))
+	}
+	// the total of each row follows the cells, e.g. "  (123)"
+	cellWidth := min(max((chartWidth()-labelWidth-10)/max(len(labels), 1), 5), maxCellWidth)
+
+	labelWidth++
+	header := []string{pad(rowTitle, labelWidth)}
+	for _, label := range labels {
+		header = append(header, center(label, cellWidth))
+	}
+	fmt.Println(strings.Join(header, ""))
+
+	for i, row := range counts {
+		total := 0
+		for _, count := range row {
+			total += count
+		}
+		line := pad(labels[i], labelWidth)
+		for _, count := range row {
+			if total == 0 {
+				line += center("-", cellWidth)
+				continue
+			}
+			share := float64(count) / float64(total)
+			line += heatColor(share) + center(fmt.Sprintf("%.0f%%", 100*share), cellWidth) + resetColors
+		}
+		fmt.Printf("%s  (%d)\n", line, total)
+	}
+}
+
+// heatColor returns the ANSI sequence of the background color of a share between 0 and 1, with black text.
+func heatColor(share float64) string {
+	i := min(int(share*float64(len(heatColors))), len(heatColors)-1)
+	return fmt.Sprintf("\u001B[30;48;5;%dm", heatColors[i])
+}
+
+// DisplaySparkline draws the values on one line, averaging consecutive values when there are more
+// than the width of the screen.
+func DisplaySparkline(title string, values []int) {
+	if len(values) == 0 {
+		fmt.Printf("%s: no values\n", title)
+		return
+	}
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low, high = min(low, value), max(high, value)
+	}
+	suffix := fmt.Sprintf(" min %d, max %d, last %d", low, high, values[len(values)-1])
+	width := max(chartWidth()-utf8.RuneCountInString(title)-utf8.RuneCountInString(suffix)-2, 1)
+
+	line := make([]rune, 0, width)
+	for _, value := range resample(values, width) {
+		level := 0
+		if high > low {
+			level = int(math.Round((value - float64(low)) / float64(high-low) * float64(len(sparks)-1)))
+		}
+		line = append(line, sparks[level])
+	}
+	fmt.Printf("%s: %s%s\n", title, string(line), suffix)
+}
+
+// resample averages the values into at most n buckets of consecutive values.
+func resample(values []int, n int) []float64 {
+	buckets := min(len(values), n)
+	resampled := make([]float64, buckets)
+	for i := range resampled {
+		from, to := i*len(values)/buckets, (i+1)*len(values)/buckets
+		sum := 0
+		for _, value := range values[from:to] {
+			sum += value
+		}
+		resampled[i] = float64(sum) / float64(to-from)
+	}
+	return resampled
+}
+
+// DisplayBars draws a horizontal bar for each label, the longest one filling the width of the screen.
+func DisplayBars(labels []string, counts []int) {
+	labelWidth, total, highest := 0, 0, 0
+	for i, label := range labels {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(label))
+		total += counts[i]
+		highest = max(highest, counts[i])
+	}
+	// room for the label, the count and its share, e.g. " 123 (45%)"
+	countWidth := len(fmt.Sprint(highest))
+	width := max(chartWidth()-labelWidth-countWidth-10, 1)
+
+	for i, label := range labels {
+		length, share := 0, 0.0
+		if highest > 0 {
+			length = int(math.Round(float64(counts[i]) / float64(highest) * float64(width)))
+			share = 100 * float64(counts[i]) / float64(total)
+		}
+		fmt.Printf("%s %s %*d (%.0f%%)\n", pad(label, labelWidth), strings.Repeat("█", length)+strings.Repeat(" ", width-length),
+			countWidth, counts[i], share)
+	}
+}
+
+// pad right-pads the text with spaces to width runes.
+func pad(text string, width int) string {
+	return text + strings.Repeat(" ", max(width-utf8.RuneCountInString(text), 0))
+}
+
+// center pads the text with spaces on both sides to width runes.
+func center(text string, width int) string {
+	left := max(width-utf8.RuneCountInString(text), 0) / 2
+	return pad(strings.Repeat(" ", left)+text, width)
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+// setScreenWidth fixes the width of the screen for the test.
+func setScreenWidth(t *testing.T, width int) {
+	previous := screenWidth
+	screenWidth = width
+	t.Cleanup(func() { screenWidth = previous })
+}
+
+func TestDisplayHeatmap(t *testing.T) {
+	setScreenWidth(t, 40)
+	out, err := testutils.CaptureStdout(func() {
+		DisplayHeatmap("after", []string{"A", "B"}, [][]int{{3, 1}, {0, 0}})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "after      A           B      \n"+
+		"A     \u001B[30;48;5;202m    75%     \u001B[0m\u001B[30;48;5;228m    25%     \u001B[0m  (4)\n"+
+		"B          -           -        (0)\n", out)
+}
+
+func TestHeatColor(t *testing.T) {
+	assert.Equal(t, "\u001B[30;48;5;230m", heatColor(0))
+	assert.Equal(t, "\u001B[30;48;5;214m", heatColor(0.5))
+	assert.Equal(t, "\u001B[30;48;5;196m", heatColor(1))
+}
+
+func TestDisplaySparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		values []int
+		want   string
+	}{
+		{"one spark per value", 80, []int{0, 1, 2, 3, 4, 5, 6, 7}, "score: ▁▂▃▄▅▆▇█ min 0, max 7, last 7\n"},
+		{"averaged to the width", 32, []int{0, 0, 7, 7, 0, 0, 7, 7}, "score: ▁█▁█ min 0, max 7, last 7\n"},
+		{"constant", 80, []int{2, 2}, "score: ▁▁ min 2, max 2, last 2\n"},
+		{"no values", 80, nil, "score: no values\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setScreenWidth(t, tt.width)
+			out, err := testutils.CaptureStdout(func() {
+				DisplaySparkline("score", tt.values)
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, out)
+		})
+	}
+}
+
+func TestDisplayBars(t *testing.T) {
+	setScreenWidth(t, 26)
+	out, err := testutils.CaptureStdout(func() {
+		DisplayBars([]string{"Rock", "Paper"}, []int{10, 5})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Rock  █████████ 10 (67%)\nPaper █████      5 (33%)\n", out)
+}
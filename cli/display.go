@@ -11,6 +11,9 @@ import (
 	"github.com/jedib0t/go-pretty/v6/text"
 	"golang.org/x/term"
 
+	"github.com/yuripiffer/rock-paper-scissors/botproto"
+	"github.com/yuripiffer/rock-paper-scissors/fairness"
+	"github.com/yuripiffer/rock-paper-scissors/history"
 	"github.com/yuripiffer/rock-paper-scissors/model"
 )
 
@@ -82,6 +85,33 @@ func CongratulationsWinner(name string) {
 	fmt.Printf("\n%s is the WINNER of the game!!!\n\n", redText(name))
 }
 
+// DisplayRivalry prints the head-to-head record of two sides that have met before.
+func DisplayRivalry(rivalry history.Rivalry) {
+	a, b := rivalry.Sides[0].String(), rivalry.Sides[1].String()
+	fmt.Println(redText("=== RIVALRY: " + a + " vs " + b + " ==="))
+	games := "games"
+	if rivalry.Games() == 1 {
+		games = "game"
+	}
+	fmt.Printf("Head-to-head: %s %d - %d %s in %d %s\n",
+		a, rivalry.Wins[0], rivalry.Wins[1], b, rivalry.Games(), games)
+	streakHolder := rivalry.Sides[rivalry.StreakSide].String()
+	if rivalry.Streak > 1 {
+		fmt.Printf("Current streak: %s has won the last %d games\n", streakHolder, rivalry.Streak)
+	} else {
+		fmt.Printf("Current streak: %s won the last game\n", streakHolder)
+	}
+	if comeback := rivalry.Comeback; comeback.Deficit > 0 {
+		score := comeback.Score
+		if comeback.Side == 1 {
+			score[0], score[1] = score[1], score[0]
+		}
+		fmt.Printf("Biggest comeback: %s won from %d-%d down on %s\n",
+			rivalry.Sides[comeback.Side], score[0], score[1], comeback.At.Format(time.DateOnly))
+	}
+	fmt.Println()
+}
+
 func DisplayRoundWinner(winnerMove, loserMove model.Move, winnerName string) {
 	fmt.Printf("%s beats %s, %s wins the round!\n",
 		model.MoveToStr[winnerMove],
@@ -95,6 +125,89 @@ func DisplayThrows(players []model.Player) {
 	}
 }
 
+// DisplayExplanations prints the reasoning of the players that explain their moves.
+func DisplayExplanations(players []model.Player) {
+	for _, p := range players {
+		explainer, ok := p.(model.Explainer)
+		if !ok {
+			continue
+		}
+		if reason := explainer.Explain(); reason != "" {
+			fmt.Printf("%s explains: %s\n", p.GetName(), reason)
+		}
+	}
+}
+
+// DisplayCommitments prints the commitments of the players that commit to their move, and returns
+// them by player name so their reveals can be checked against what was shown.
+func DisplayCommitments(players []model.Player) map[string]string {
+	shown := map[string]string{}
+	for _, p := range players {
+		committer, ok := p.(model.Committer)
+		if !ok {
+			continue
+		}
+		if commitment := committer.Commitment(); commitment != "" {
+			fmt.Printf("%s has chosen its move, commitment %s\n", p.GetName(), commitment)
+			shown[p.GetName()] = commitment
+		}
+	}
+	return shown
+}
+
+// DisplayReveals prints the moves and nonces behind the commitments shown before the round, by
+// player name, and whether they match.
+func DisplayReveals(players []model.Player, shown map[string]string) {
+	for _, p := range players {
+		committer, ok := p.(model.Committer)
+		commitment := shown[p.GetName()]
+		if !ok || commitment == "" {
+			continue
+		}
+		move, nonce := committer.Reveal()
+		fmt.Printf("%s reveals nonce %s: sha256(%q) = %s",
+			p.GetName(), nonce, fairness.Preimage(move, nonce), fairness.Commit(move, nonce))
+		if fairness.Verify(commitment, move, nonce) {
+			fmt.Println(", as committed")
+		} else {
+			fmt.Println(", " + redText("which does NOT match the commitment "+commitment))
+		}
+	}
+}
+
+// DisplayTable prints a report table with a header row.
+func DisplayTable(header table.Row, rows []table.Row) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(header)
+	t.AppendRows(rows)
+	t.Render()
+}
+
+// DisplayBotCheck prints the conformance report of an external bot.
+func DisplayBotCheck(results []botproto.CheckResult) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"CHECK", "RESULT", "DETAIL"})
+	for _, result := range results {
+		status := "PASS"
+		switch {
+		case result.Skipped:
+			status = "SKIP"
+		case !result.Passed:
+			status = redText("FAIL")
+		}
+		t.AppendRow(table.Row{result.Name, status, result.Detail})
+	}
+	t.Render()
+
+	if botproto.Passed(results) {
+		fmt.Println("The bot follows the protocol.")
+	} else {
+		displayRedText("The bot does not follow the protocol.")
+	}
+}
+
 func centerText(text string) string {
 	width, err := screenWidthSingleton()
 	if err != nil {
@@ -115,8 +228,12 @@ func goodbye() {
 	time.Sleep(100 * time.Millisecond)
 }
 
+// redText highlights the text in the color of the theme, red by default.
 func redText(text string) string {
-	return redTextPrefix + text + redTextSuffix
+	if highlightPrefix == "" {
+		return text
+	}
+	return highlightPrefix + text + redTextSuffix
 }
 
 func displayRedText(text string) {
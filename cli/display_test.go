@@ -6,8 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yuripiffer/rock-paper-scissors/botproto"
+	"github.com/yuripiffer/rock-paper-scissors/fairness"
+	"github.com/yuripiffer/rock-paper-scissors/history"
 	"github.com/yuripiffer/rock-paper-scissors/model"
 	"github.com/yuripiffer/rock-paper-scissors/testutils"
 )
@@ -62,6 +66,32 @@ func TestCongratulationsWinner(t *testing.T) {
 	assert.Contains(t, out, redTextPrefix+"ALICE"+redTextSuffix+" is the WINNER of the game!!!\n\n")
 }
 
+func TestDisplayRivalry(t *testing.T) {
+	out, err := testutils.CaptureStdout(func() {
+		DisplayRivalry(history.Rivalry{
+			Sides:      [2]history.Side{{Name: "ANA"}, {Name: "ROBOT", Strategy: "heuristic"}},
+			Wins:       [2]int{2, 3},
+			Streak:     3,
+			StreakSide: 1,
+			Comeback: history.Comeback{
+				Side: 1, Deficit: 2, Score: [2]int{2, 0}, At: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			},
+		})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, "RIVALRY: ANA vs ROBOT (heuristic)")
+	assert.Contains(t, out, "Head-to-head: ANA 2 - 3 ROBOT (heuristic) in 5 games\n")
+	assert.Contains(t, out, "Current streak: ROBOT (heuristic) has won the last 3 games\n")
+	assert.Contains(t, out, "Biggest comeback: ROBOT (heuristic) won from 0-2 down on 2024-05-01\n")
+
+	out, err = testutils.CaptureStdout(func() {
+		DisplayRivalry(history.Rivalry{Sides: [2]history.Side{{Name: "ANA"}, {Name: "PAUL"}}, Wins: [2]int{1, 0}, Streak: 1})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, "Current streak: ANA won the last game\n")
+	assert.NotContains(t, out, "comeback")
+}
+
 func TestDisplayRoundWinner(t *testing.T) {
 	out, err := testutils.CaptureStdout(func() {
 		DisplayRoundWinner(model.Rock, model.Scissors, "BOB")
@@ -88,6 +118,116 @@ func TestDisplayThrows(t *testing.T) {
 	assert.Contains(t, out, "B plays Paper")
 }
 
+func TestDisplayExplanations(t *testing.T) {
+	p1 := &model.PlayerMock{
+		GetNameFunc: func() string { return "A" },
+	}
+	p2 := &explainerMock{
+		PlayerMock: &model.PlayerMock{GetNameFunc: func() string { return "B" }},
+		reason:     "because I can",
+	}
+	p3 := &explainerMock{
+		PlayerMock: &model.PlayerMock{GetNameFunc: func() string { return "C" }},
+	}
+	out, err := testutils.CaptureStdout(func() {
+		DisplayExplanations([]model.Player{p1, p2, p3})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "B explains: because I can\n", out)
+}
+
+func TestDisplayCommitments(t *testing.T) {
+	p1 := &model.PlayerMock{
+		GetNameFunc: func() string { return "A" },
+	}
+	p2 := &committerMock{PlayerMock: &model.PlayerMock{GetNameFunc: func() string { return "B" }}}
+	var shown map[string]string
+	out, err := testutils.CaptureStdout(func() {
+		shown = DisplayCommitments([]model.Player{p1, p2})
+		DisplayReveals([]model.Player{p1, p2}, shown)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"B": fairness.Commit(model.Paper, "ab12")}, shown)
+	assert.Equal(t, "B has chosen its move, commitment "+fairness.Commit(model.Paper, "ab12")+"\n"+
+		"B reveals nonce ab12: sha256(\"paper:ab12\") = "+fairness.Commit(model.Paper, "ab12")+", as committed\n", out)
+
+	// the reveal is checked against the commitment shown before the round, not the current one
+	out, err = testutils.CaptureStdout(func() {
+		DisplayReveals([]model.Player{p1, p2}, map[string]string{"B": fairness.Commit(model.Rock, "ab12")})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, "which does NOT match the commitment "+fairness.Commit(model.Rock, "ab12"))
+}
+
+// committerMock is a Player that commits to paper.
+type committerMock struct {
+	*model.PlayerMock
+}
+
+func (r *committerMock) Commitment() string {
+	return fairness.Commit(model.Paper, "ab12")
+}
+
+func (r *committerMock) Reveal() (model.Move, string) {
+	return model.Paper, "ab12"
+}
+
+// explainerMock is a Player that also explains its moves.
+type explainerMock struct {
+	*model.PlayerMock
+	reason string
+}
+
+func (r *explainerMock) Explain() string {
+	return r.reason
+}
+
+func TestDisplayTable(t *testing.T) {
+	out, err := testutils.CaptureStdout(func() {
+		DisplayTable(table.Row{"NAME", "POINTS"}, []table.Row{{"ROBOT", 7}, {"ANA", 3}})
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, "| NAME  | POINTS |")
+	assert.Contains(t, out, "| ROBOT |      7 |")
+	assert.Contains(t, out, "| ANA   |      3 |")
+}
+
+func TestDisplayBotCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []botproto.CheckResult
+		want    []string
+	}{
+		{
+			name: "every check passed",
+			results: []botproto.CheckResult{
+				{Name: "handshake", Passed: true, Detail: "hello"},
+			},
+			want: []string{"handshake", "PASS", "hello", "The bot follows the protocol."},
+		},
+		{
+			name: "a check failed",
+			results: []botproto.CheckResult{
+				{Name: "handshake", Passed: true},
+				{Name: "game", Detail: "illegal move"},
+				{Name: "rematch", Skipped: true},
+			},
+			want: []string{redTextPrefix + "FAIL" + redTextSuffix, "illegal move", "SKIP", "does not follow the protocol"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := testutils.CaptureStdout(func() {
+				DisplayBotCheck(tt.results)
+			})
+			assert.NoError(t, err)
+			for _, want := range tt.want {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
+
 func TestCenterText(t *testing.T) {
 	s := centerText("test")
 	assert.Contains(t, s, "          test")
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// themes maps the theme names to the ANSI sequence of the highlighted texts, such as the winners.
+var themes = map[string]string{
+	"red":   redTextPrefix,
+	"green": "\u001B[1;32m",
+	"blue":  "\u001B[1;34m",
+	"plain": "",
+}
+
+// highlightPrefix starts the highlighted texts in the color of the theme.
+var highlightPrefix = redTextPrefix
+
+// Themes returns the names of the themes, sorted.
+func Themes() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// SetTheme highlights the texts in the color of the named theme.
+func SetTheme(name string) error {
+	prefix, ok := themes[name]
+	if !ok {
+		return fmt.Errorf("unknown theme %q, want one of %s", name, strings.Join(Themes(), ", "))
+	}
+	highlightPrefix = prefix
+	return nil
+}
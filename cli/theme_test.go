@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTheme(t *testing.T) {
+	defer func() { highlightPrefix = redTextPrefix }()
+
+	assert.Equal(t, []string{"blue", "green", "plain", "red"}, Themes())
+
+	assert.NoError(t, SetTheme("blue"))
+	assert.Equal(t, "\u001B[1;34mwinner"+redTextSuffix, redText("winner"))
+
+	assert.NoError(t, SetTheme("plain"))
+	assert.Equal(t, "winner", redText("winner"))
+
+	assert.EqualError(t, SetTheme("pink"), `unknown theme "pink", want one of blue, green, plain, red`)
+	assert.Equal(t, "winner", redText("winner"), "an unknown theme changes nothing")
+}
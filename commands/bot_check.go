@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/botproto"
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+)
+
+// BotCheck runs an external bot through the protocol conformance checks and prints the report.
+func BotCheck(args []string) error {
+	flags := newFlagSet("bot-check")
+	timeout := flags.Duration("timeout", 2*time.Second, "time the bot has to answer each message")
+	rounds := flags.Int("rounds", 20, "number of rounds played during the game check")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	command := flags.Args()
+	if len(command) == 1 {
+		command = strings.Fields(command[0])
+	}
+	if len(command) == 0 {
+		return errors.New("missing bot command")
+	}
+
+	results := botproto.Check(botproto.CheckConfig{
+		Command: command,
+		Timeout: *timeout,
+		Rounds:  *rounds,
+		Stderr:  os.Stderr,
+	})
+	cli.DisplayBotCheck(results)
+	if !botproto.Passed(results) {
+		return errors.New("bot check failed")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestBotCheck(t *testing.T) {
+	restoreStdout, err := testutils.SilenceStdout()
+	assert.NoError(t, err)
+	defer restoreStdout()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing command", []string{}, "missing bot command"},
+		{"unknown flag", []string{"--speed", "1"}, "flag provided but not defined: -speed"},
+		{"command cannot be launched", []string{"./does-not-exist"}, "bot check failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := BotCheck(tt.args)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Charts draws the move transitions, the score over time and the moves of a player from the match history.
+func Charts(args []string) error {
+	flags := newFlagSet("charts")
+	player := flags.String("player", "", "name of the player")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	name := playerName(*player)
+	if name == "" {
+		return errors.New("charts needs a player: --player NAME")
+	}
+
+	store, err := history.Open()
+	if err != nil {
+		return err
+	}
+	matches, err := store.Matches()
+	if err != nil {
+		return err
+	}
+	stats := history.Summarize(matches, name)
+	if len(stats.Score) == 0 {
+		fmt.Printf("No rounds of %s in the history yet.\n", name)
+		return nil
+	}
+
+	labels := make([]string, 0, len(model.Moves))
+	for _, move := range model.Moves {
+		labels = append(labels, model.MoveToStr[move])
+	}
+	transitions := make([][]int, 0, len(stats.Transitions))
+	for _, row := range stats.Transitions {
+		transitions = append(transitions, row[:])
+	}
+
+	fmt.Printf("%s: next move after each move, as a share of the row\n", name)
+	cli.DisplayHeatmap("after", labels, transitions)
+	fmt.Println()
+	cli.DisplaySparkline("Rounds won minus lost", stats.Score)
+	fmt.Println()
+	cli.DisplayBars(labels, stats.Moves[:])
+	return nil
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestCharts(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := history.Open()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Append(model.Match{
+		Players: [2]string{"ANA", "ROBOT"},
+		Winner:  "ANA",
+		Rounds: []model.MatchRound{
+			{Moves: [2]model.Move{model.Rock, model.Scissors}, Winner: "ANA"},
+			{Moves: [2]model.Move{model.Paper, model.Rock}, Winner: "ANA"},
+		},
+	}))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "player",
+			args: []string{"--player", "ana"},
+			wantOuts: []string{
+				"ANA: next move after each move, as a share of the row",
+				"100%",
+				"Rounds won minus lost: ▁█ min 1, max 2, last 2",
+				"Scissors",
+			},
+		},
+		{
+			name:    "no player",
+			wantErr: true,
+		},
+		{
+			name:     "unknown player",
+			args:     []string{"--player", "zoe"},
+			wantOuts: []string{"No rounds of ZOE in the history yet."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Charts(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Package commands implements the subcommands of the game binary that run outside the interactive game.
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Command is a subcommand, invoked as the first argument of the binary.
+type Command struct {
+	Name        string
+	Usage       string
+	Description string
+	Run         func(args []string) error
+}
+
+var registry = []Command{
+	{
+		Name:        "bot-check",
+		Usage:       "bot-check [--timeout 2s] [--rounds 20] <command>",
+		Description: "checks that an external bot follows the protocol",
+		Run:         BotCheck,
+	},
+	{
+		Name:        "simulate",
+		Usage:       "simulate --p1 <strategy> --p2 <strategy> [--games N] [--seed S]",
+		Description: "plays headless games between two strategies",
+		Run:         Simulate,
+	},
+	{
+		Name:        "tournament",
+		Usage:       "tournament [--strategy S]... [--games N] [--seed S] [--csv file]",
+		Description: "plays a round-robin between strategies and prints the standings",
+		Run:         Tournament,
+	},
+	{
+		Name:        "exploit",
+		Usage:       "exploit [--strategy S]... [--memory K] [--seed S]",
+		Description: "reports how many points per round best responses win against strategies",
+		Run:         Exploit,
+	},
+	{
+		Name:        "train",
+		Usage:       "train [--episodes N] [--alpha A] [--epsilon E] [--seed S]",
+		Description: "trains the qlearning strategy against the built-in strategies",
+		Run:         Train,
+	},
+	{
+		Name:        "evolve",
+		Usage:       "evolve [--population N] [--generations N] [--seed S]",
+		Description: "evolves rule programs and exports the best one as the evolved strategy",
+		Run:         Evolve,
+	},
+	{
+		Name:        "profile",
+		Usage:       "profile list | show NAME | set NAME key=value... | delete NAME",
+		Description: "manages the local player profiles and their preferences",
+		Run:         Profile,
+	},
+	{
+		Name:        "opponent",
+		Usage:       "opponent list | show NAME | reset NAME | delete NAME",
+		Description: "manages the profiles the computer learned about players",
+		Run:         Opponent,
+	},
+	{
+		Name:        "randcheck",
+		Usage:       "randcheck [--draws N] [--random source] [--seed S]",
+		Description: "tests that the computer's random moves are uniform and independent",
+		Run:         RandCheck,
+	},
+	{
+		Name:        "verify",
+		Usage:       "verify <transcript>",
+		Description: "checks the computer's moves of a transcript against their commitments",
+		Run:         Verify,
+	},
+	{
+		Name:        "stats",
+		Usage:       "stats [--player NAME]",
+		Description: "reports the results and habits of a player from the match history",
+		Run:         Stats,
+	},
+	{
+		Name:        "predictability",
+		Usage:       "predictability --player NAME",
+		Description: "reports how predictable a player is from the match history",
+		Run:         Predictability,
+	},
+	{
+		Name:        "charts",
+		Usage:       "charts [--player NAME]",
+		Description: "draws the move transitions, score and moves of a player from the match history",
+		Run:         Charts,
+	},
+	{
+		Name:        "leaderboard",
+		Usage:       "leaderboard [--tau T] [--period D] [--min-games N] [--recompute]",
+		Description: "ranks players and strategies by their Glicko-2 rating",
+		Run:         Leaderboard,
+	},
+	{
+		Name:        "season",
+		Usage:       "season list | show NAME | add NAME START END | delete NAME",
+		Description: "schedules the seasons of the ladder and shows their standings",
+		Run:         Season,
+	},
+	{
+		Name:        "replay",
+		Usage:       "replay [--speed X] [--paused] [ID]",
+		Description: "replays a match of the history, or lists the matches",
+		Run:         Replay,
+	},
+}
+
+// Lookup returns the command with the given name.
+func Lookup(name string) (Command, bool) {
+	for _, command := range registry {
+		if command.Name == name {
+			return command, true
+		}
+	}
+	return Command{}, false
+}
+
+// PrintUsage writes the list of commands.
+func PrintUsage(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "Commands:")
+	for _, command := range registry {
+		_, _ = fmt.Fprintf(w, "  %-50s %s\n", command.Usage, command.Description)
+	}
+}
+
+// isFlagSet reports whether the flag was given on the command line.
+func isFlagSet(flags *flag.FlagSet, name string) bool {
+	set := false
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// playerName returns the name a player is saved under: in upper case, as entered in the game.
+func playerName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+// newFlagSet creates the flag set of a command, reporting errors instead of exiting.
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// specsFlag is a repeatable flag of strategy specs, one per occurrence, as specs may contain commas
+// themselves, e.g. --strategy biased:5,3,2 --strategy cycler.
+type specsFlag []string
+
+func (r *specsFlag) String() string {
+	return strings.Join(*r, " ")
+}
+
+func (r *specsFlag) Set(spec string) error {
+	if slices.Contains(*r, spec) {
+		return fmt.Errorf("strategy %q is given twice", spec)
+	}
+	*r = append(*r, spec)
+	return nil
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup string
+		wantOk bool
+	}{
+		{"registered command", "bot-check", true},
+		{"unknown command", "dance", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, ok := Lookup(tt.lookup)
+			assert.Equal(t, tt.wantOk, ok)
+			if ok {
+				assert.Equal(t, tt.lookup, command.Name)
+				assert.NotNil(t, command.Run)
+			}
+		})
+	}
+}
+
+func TestPrintUsage(t *testing.T) {
+	out := &bytes.Buffer{}
+	PrintUsage(out)
+	for _, command := range registry {
+		assert.Contains(t, out.String(), command.Usage)
+	}
+}
+
+func Test_playerName(t *testing.T) {
+	assert.Equal(t, "ANA", playerName(" ana\n"))
+	assert.Equal(t, "", playerName("  "))
+}
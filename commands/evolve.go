@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/evolution"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+// Evolve evolves rule programs against built-in strategies and exports the best one as the evolved strategy.
+func Evolve(args []string) error {
+	flags := newFlagSet("evolve")
+	cfg := evolution.Config{}
+	var list specsFlag
+	flags.Var(&list, "opponent", "strategy of the opponent pool (repeatable, default: every strategy that needs no training)")
+	flags.IntVar(&cfg.Population, "population", 50, "programs per generation")
+	flags.IntVar(&cfg.Generations, "generations", 50, "number of generations")
+	flags.IntVar(&cfg.Games, "games", 20, "games against each opponent to measure the fitness")
+	flags.IntVar(&cfg.WinningScore, "points", 3, "points needed to win a game")
+	flags.IntVar(&cfg.MaxRounds, "max-rounds", 100, "rounds after which a game is a draw")
+	flags.IntVar(&cfg.MaxRules, "max-rules", 6, "largest number of rules of a program")
+	flags.Float64Var(&cfg.MutationRate, "mutation", 0.2, "probability of mutating each rule of a child")
+	flags.Int64Var(&cfg.Seed, "seed", 0, "seed of the evolution (default: random, printed in the report)")
+	out := flags.String("out", "", "file to export the best program to (default: "+strategies.ProgramFile+" in the data directory)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	cfg.Opponents = strategies.Zoo()
+	if len(list) > 0 {
+		cfg.Opponents = list
+	}
+	if !isFlagSet(flags, "seed") {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	path := *out
+	if path == "" {
+		var err error
+		if path, err = storage.Path(strategies.ProgramFile); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Evolving %d programs for %d generations against %s, seed %d\n",
+		cfg.Population, cfg.Generations, strings.Join(cfg.Opponents, ", "), cfg.Seed)
+	best, err := evolution.Evolve(cfg, func(generation int, best evolution.Individual) {
+		fmt.Printf("generation %d: best fitness %+.3f\n", generation, best.Fitness)
+	})
+	if err != nil {
+		return err
+	}
+	if err = strategies.SaveProgram(path, best.Program); err != nil {
+		return err
+	}
+
+	fmt.Printf("Best program (fitness %+.3f: games won minus games lost, per game):\n%s\n", best.Fitness, best.Program)
+	fmt.Printf("Program saved to %s\n", path)
+	return nil
+}
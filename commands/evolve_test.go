@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestEvolve(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "program.json")
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "best program exported",
+			args: []string{"--opponent", "rock", "--population", "5", "--generations", "2", "--games", "2", "--seed", "1", "--out", path},
+			wantOuts: []string{
+				"Evolving 5 programs for 2 generations against rock, seed 1",
+				"generation 2: best fitness",
+				"otherwise: play at random",
+				"Program saved to " + path,
+			},
+		},
+		{
+			name:    "population too small",
+			args:    []string{"--population", "1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Evolve(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+
+	_, err := strategies.New("evolved:"+path, nil)
+	assert.NoError(t, err, "the exported program is a loadable strategy")
+}
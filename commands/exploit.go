@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/analysis"
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+// Exploit measures how many points per round learned best responses win against strategies.
+func Exploit(args []string) error {
+	flags := newFlagSet("exploit")
+	cfg := analysis.Config{}
+	var list specsFlag
+	flags.Var(&list, "strategy", "strategy to exploit (repeatable, default: every strategy that needs no training)")
+	flags.IntVar(&cfg.Memory, "memory", 2, fmt.Sprintf("longest context, in rounds, the best responses learn from (0-%d)", analysis.MaxMemory))
+	flags.IntVar(&cfg.TrainRounds, "train", 10000, "rounds the best responses learn before the measure")
+	flags.IntVar(&cfg.Rounds, "rounds", 10000, "measured rounds")
+	flags.Int64Var(&cfg.Seed, "seed", 0, "seed of the strategies (default: random, printed in the report)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	names := strategies.Zoo()
+	if len(list) > 0 {
+		names = list
+	}
+	if !isFlagSet(flags, "seed") {
+		cfg.Seed = time.Now().UnixNano()
+	}
+
+	reports := make([]analysis.Report, 0, len(names))
+	for _, name := range names {
+		cfg.Strategy = name
+		report, err := analysis.Measure(cfg)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		reports = append(reports, report)
+	}
+	displayExploits(reports, cfg)
+	return nil
+}
+
+func displayExploits(reports []analysis.Report, cfg analysis.Config) {
+	fmt.Printf("Best responses with up to %d rounds of memory, %d training and %d measured rounds, seed %d\n",
+		cfg.Memory, cfg.TrainRounds, cfg.Rounds, cfg.Seed)
+
+	rows := make([]table.Row, 0, len(reports))
+	for _, report := range reports {
+		best := report.Best()
+		rows = append(rows, table.Row{
+			report.Config.Strategy,
+			best.Memory,
+			best.Rounds.Wins,
+			best.Rounds.Draws,
+			best.Rounds.Losses,
+			fmt.Sprintf("%+.3f", best.PointsPerRound()),
+		})
+	}
+	cli.DisplayTable(table.Row{"STRATEGY", "MEMORY", "BR WINS", "DRAWS", "BR LOSSES", "POINTS PER ROUND"}, rows)
+	fmt.Println("Points per round: rounds won minus rounds lost by the best response; 1 is fully predictable, 0 unexploitable.")
+}
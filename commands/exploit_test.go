@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestExploit(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "predictable strategies",
+			args: []string{"--strategy", "rock", "--strategy", "computer", "--memory", "1", "--train", "100", "--rounds", "1000", "--seed", "1"},
+			wantOuts: []string{
+				"Best responses with up to 1 rounds of memory, 100 training and 1000 measured rounds, seed 1",
+				"| rock     |      0 |    1000 |     0 |         0 | +1.000           |",
+				"| computer |      1 |    1000 |     0 |         0 | +1.000           |",
+			},
+		},
+		{
+			name:    "unknown strategy",
+			args:    []string{"--strategy", "lizard"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Exploit(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
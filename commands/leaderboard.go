@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
+)
+
+// Leaderboard ranks humans and strategies by their Glicko-2 rating, recomputing the ratings from the
+// match history when they are missing, out of date or asked for with other parameters.
+func Leaderboard(args []string) error {
+	flags := newFlagSet("leaderboard")
+	cfg := rating.DefaultConfig()
+	flags.Float64Var(&cfg.Tau, "tau", cfg.Tau, "how fast the volatility of the ratings changes")
+	flags.DurationVar(&cfg.Period, "period", cfg.Period, "inactivity after which the rating deviation grows")
+	minGames := flags.Int("min-games", 1, "games a player needs to be ranked")
+	recompute := flags.Bool("recompute", false, "recompute the ratings from the match history")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if cfg.Tau <= 0 {
+		return errors.New("tau must be positive")
+	}
+
+	store, err := history.Open()
+	if err != nil {
+		return err
+	}
+	matches, err := store.Matches()
+	if err != nil {
+		return err
+	}
+	ratings, err := rating.Load()
+	if err != nil {
+		return err
+	}
+	if ratings != nil && !isFlagSet(flags, "tau") && !isFlagSet(flags, "period") {
+		// keep the parameters the ratings were computed with
+		cfg = ratings.Config
+	}
+	var seasons []rating.Season
+	if ratings != nil {
+		seasons = ratings.Seasons
+	}
+	if ratings == nil || *recompute || ratings.Config != cfg || ratings.Matches != len(matches) {
+		ratings = rating.Compute(matches, cfg, seasons)
+	}
+	// the seasons that ended or started since the last game are archived or started
+	now := time.Now()
+	ratings.Advance(now)
+	if err = rating.Save(ratings); err != nil {
+		return err
+	}
+
+	rows := []table.Row{}
+	for _, player := range ratings.Ranking(now) {
+		if player.Games < *minGames {
+			continue
+		}
+		kind := "strategy"
+		if player.Human {
+			kind = "human"
+		}
+		rows = append(rows, table.Row{
+			len(rows) + 1,
+			player.Name,
+			kind,
+			fmt.Sprintf("%.0f", player.Rating.Rating),
+			fmt.Sprintf("±%.0f", 2*player.Deviation),
+			player.Games,
+			player.Wins,
+			player.LastPlayed.Format(time.DateOnly),
+		})
+	}
+	if len(rows) == 0 {
+		fmt.Println("No rated players yet.")
+		return nil
+	}
+	cli.DisplayTable(table.Row{"RANK", "PLAYER", "KIND", "RATING", "95%", "GAMES", "WON", "LAST PLAYED"}, rows)
+	fmt.Printf("Glicko-2 ratings of %d games, tau %.2f; the 95%% interval widens after every %s without games.\n",
+		ratings.Matches, ratings.Config.Tau, ratings.Config.Period)
+	if ratings.Current != nil {
+		fmt.Printf("Season %s runs until %s.\n", ratings.Current.Season.Name, seasonEnd(ratings.Current.Season))
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestLeaderboard(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := history.Open()
+	assert.NoError(t, err)
+	for _, winner := range []string{"ANA", "ANA", "PAUL"} {
+		assert.NoError(t, store.Append(model.Match{
+			Players:    [2]string{winner, "ROBOT"},
+			Strategies: [2]string{"", "heuristic"},
+			Winner:     winner,
+			EndedAt:    time.Now(),
+		}))
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "computed from the history",
+			wantOuts: []string{
+				"|    1 | ANA       | human    |",
+				"|    3 | heuristic | strategy |",
+				"Glicko-2 ratings of 3 games, tau 0.50",
+			},
+		},
+		{
+			name:     "other parameters",
+			args:     []string{"--tau", "0.3", "--min-games", "3"},
+			wantOuts: []string{"|    1 | heuristic | strategy |", "tau 0.30"},
+		},
+		{
+			name:     "keeps the parameters of the saved ratings",
+			args:     []string{"--recompute"},
+			wantOuts: []string{"tau 0.30"},
+		},
+		{
+			name:     "nobody played enough",
+			args:     []string{"--min-games", "10"},
+			wantOuts: []string{"No rated players yet."},
+		},
+		{
+			name:    "invalid tau",
+			args:    []string{"--tau", "0"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Leaderboard(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+
+	ratings, err := rating.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, ratings.Matches, "the ratings are saved")
+}
+
+func TestLeaderboard_seasons(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := history.Open()
+	assert.NoError(t, err)
+	played := time.Date(2024, 6, 1, 12, 0, 0, 0, time.Local)
+	match := model.Match{
+		Players:    [2]string{"ANA", "ROBOT"},
+		Strategies: [2]string{"", "heuristic"},
+		Winner:     "ANA",
+		EndedAt:    played,
+	}
+	assert.NoError(t, store.Append(match))
+	today := time.Now().Format(time.DateOnly)
+	nextWeek := time.Now().AddDate(0, 0, 7).Format(time.DateOnly)
+	_, err = testutils.CaptureStdout(func() {
+		assert.NoError(t, Season([]string{"add", "past", "2024-01-01", "2024-12-31"}))
+		assert.NoError(t, Season([]string{"add", "now", today, nextWeek}))
+	})
+	assert.NoError(t, err)
+	// a game of the past season the ratings do not know about yet
+	match.EndedAt = played.AddDate(0, 0, 1)
+	assert.NoError(t, store.Append(match))
+
+	out, err := testutils.CaptureStdout(func() {
+		assert.NoError(t, Leaderboard(nil))
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, "Glicko-2 ratings of 2 games")
+	assert.Contains(t, out, "Season now runs until "+nextWeek+".")
+
+	ratings, err := rating.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ratings.Matches, "the ratings are recomputed")
+	if assert.Len(t, ratings.Archive, 1, "the past season is archived after the recompute") {
+		assert.Equal(t, "past", ratings.Archive[0].Season.Name)
+		assert.Equal(t, 2, ratings.Archive[0].Games)
+	}
+	if assert.NotNil(t, ratings.Current) {
+		assert.Equal(t, "now", ratings.Current.Season.Name)
+	}
+}
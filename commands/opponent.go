@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+)
+
+// Opponent lists, shows, resets or deletes the profiles the computer learned about human players.
+func Opponent(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: opponent list | show NAME | reset NAME | delete NAME")
+	}
+	action, names := args[0], args[1:]
+	if action == "list" {
+		return listOpponents()
+	}
+	if len(names) != 1 {
+		return fmt.Errorf("opponent %s needs exactly one player name", action)
+	}
+	name := playerName(names[0])
+
+	switch action {
+	case "show":
+		profile, err := opponents.Load(name)
+		if err != nil {
+			return err
+		}
+		displayOpponent(profile)
+		return nil
+	case "reset":
+		profile, err := opponents.Load(name)
+		if err != nil {
+			return err
+		}
+		profile.Reset()
+		if err = opponents.Save(profile); err != nil {
+			return err
+		}
+		fmt.Printf("The profile of %s was reset.\n", name)
+		return nil
+	case "delete":
+		if err := opponents.Delete(name); err != nil {
+			return err
+		}
+		fmt.Printf("The profile of %s was deleted.\n", name)
+		return nil
+	}
+	return fmt.Errorf("unknown opponent action %q", action)
+}
+
+func listOpponents() error {
+	profiles, err := opponents.List()
+	if err != nil {
+		return err
+	}
+	if len(profiles) == 0 {
+		fmt.Println("No profiles yet.")
+		return nil
+	}
+	rows := make([]table.Row, 0, len(profiles))
+	for _, profile := range profiles {
+		row := table.Row{profile.Name, profile.Rounds()}
+		rows = append(rows, append(row, shares(profile.Moves)...))
+	}
+	cli.DisplayTable(table.Row{"PLAYER", "ROUNDS", "ROCK", "PAPER", "SCISSORS"}, rows)
+	return nil
+}
+
+func displayOpponent(profile *opponents.Model) {
+	fmt.Printf("%s: %d rounds observed\n", profile.Name, profile.Rounds())
+	cli.DisplayTable(
+		table.Row{"", "ROCK", "PAPER", "SCISSORS"},
+		[]table.Row{append(table.Row{"moves"}, shares(profile.Moves)...)},
+	)
+	cli.DisplayTable(
+		table.Row{"AFTER A", "STAY", "SHIFT UP", "SHIFT DOWN"},
+		[]table.Row{
+			append(table.Row{"win"}, shares(profile.Shifts[opponents.OutcomeWin])...),
+			append(table.Row{"draw"}, shares(profile.Shifts[opponents.OutcomeDraw])...),
+			append(table.Row{"loss"}, shares(profile.Shifts[opponents.OutcomeLoss])...),
+		},
+	)
+	fmt.Println("Shift up: plays what beats the previous move; shift down: plays what the previous move beats.")
+}
+
+// shares formats counts as percentages of their total.
+func shares(counts [3]int) table.Row {
+	total := counts[0] + counts[1] + counts[2]
+	row := make(table.Row, 0, len(counts))
+	for _, count := range counts {
+		if total == 0 {
+			row = append(row, "-")
+			continue
+		}
+		row = append(row, fmt.Sprintf("%.0f%%", 100*float64(count)/float64(total)))
+	}
+	return row
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestOpponent(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	profile := opponents.NewModel("ANA")
+	profile.Observe(model.Rock, model.Scissors)
+	profile.Observe(model.Rock, model.Paper)
+	assert.NoError(t, opponents.Save(profile))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name:     "list",
+			args:     []string{"list"},
+			wantOuts: []string{"| ANA    |      2 | 100% | 0%    | 0%       |"},
+		},
+		{
+			name:     "show",
+			args:     []string{"show", "ana"},
+			wantOuts: []string{"ANA: 2 rounds observed", "| win     | 100% | 0%       | 0%         |"},
+		},
+		{
+			name:     "reset",
+			args:     []string{"reset", "ana"},
+			wantOuts: []string{"The profile of ANA was reset."},
+		},
+		{
+			name:     "show after reset",
+			args:     []string{"show", "ANA"},
+			wantOuts: []string{"ANA: 0 rounds observed"},
+		},
+		{
+			name:     "delete",
+			args:     []string{"delete", "ana"},
+			wantOuts: []string{"The profile of ANA was deleted."},
+		},
+		{
+			name:     "list without profiles",
+			args:     []string{"list"},
+			wantOuts: []string{"No profiles yet."},
+		},
+		{
+			name:    "delete a missing profile",
+			args:    []string{"delete", "ana"},
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			args:    []string{"reset"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			args:    []string{"rename", "ana"},
+			wantErr: true,
+		},
+		{
+			name:    "no action",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Opponent(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/analysis"
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+)
+
+// afterOutcome introduces the habits of the player after each outcome, indexed by opponents.OutcomeWin...
+var afterOutcome = [3]string{"After winning", "After a draw", "After losing"}
+
+// habits describe the shifts of the player after each outcome, indexed by outcome then by opponents.ShiftStay...
+var habits = [3][3]string{
+	{"stay with your winning move", "play what beats your winning move", "play the move you beat"},
+	{"play the same move again", "play what beats the drawn move", "play what the drawn move beats"},
+	{"stay with your losing move", "play the move that beat you", "switch to the move that would have beaten the winner"},
+}
+
+// Predictability reports how predictable a player was in the match history.
+func Predictability(args []string) error {
+	flags := newFlagSet("predictability")
+	player := flags.String("player", "", "name of the player")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	name := playerName(*player)
+	if name == "" {
+		return errors.New("predictability needs a player: --player NAME")
+	}
+
+	store, err := history.Open()
+	if err != nil {
+		return err
+	}
+	matches, err := store.Matches()
+	if err != nil {
+		return err
+	}
+	report := analysis.MeasurePredictability(matches, name)
+	if report.Profile.Rounds() == 0 {
+		fmt.Printf("No rounds of %s in the history yet.\n", name)
+		return nil
+	}
+	displayPredictability(report)
+	return nil
+}
+
+func displayPredictability(report analysis.Predictability) {
+	profile := report.Profile
+	moves := shares(profile.Moves)
+	fmt.Printf("%s: %d rounds in the history\n\n", profile.Name, profile.Rounds())
+	fmt.Printf("Your moves are rock %s, paper %s and scissors %s: %.2f bits of entropy out of %.2f for a random player.\n",
+		moves[0], moves[1], moves[2], report.Entropy(), analysis.MaxEntropy)
+	fmt.Printf("Knowing the last round leaves %.2f bits, so it tells %.2f bits about your next move.\n",
+		report.ConditionalEntropy(), max(report.Entropy()-report.ConditionalEntropy(), 0))
+
+	fmt.Println()
+	if rate, rounds := report.ShiftRate(opponents.OutcomeWin, opponents.ShiftStay); rounds > 0 {
+		fmt.Printf("Win-stay: you keep your move after a win %.0f%% of the time.\n", 100*rate)
+	}
+	if rate, rounds := report.ShiftRate(opponents.OutcomeLoss, opponents.ShiftStay); rounds > 0 {
+		fmt.Printf("Lose-shift: you change your move after a loss %.0f%% of the time.\n", 100*(1-rate))
+	}
+	for outcome := range afterOutcome {
+		best, bestRate, rounds := 0, 0.0, 0
+		for shift := range habits[outcome] {
+			if rate, n := report.ShiftRate(outcome, shift); rate > bestRate {
+				best, bestRate, rounds = shift, rate, n
+			}
+		}
+		if rounds > 0 {
+			fmt.Printf("%s you %s %.0f%% of the time (%d rounds).\n",
+				afterOutcome[outcome], habits[outcome][best], 100*bestRate, rounds)
+		}
+	}
+	cli.DisplayTable(
+		table.Row{"AFTER A", "STAY", "SHIFT UP", "SHIFT DOWN"},
+		[]table.Row{
+			append(table.Row{"win"}, shares(profile.Shifts[opponents.OutcomeWin])...),
+			append(table.Row{"draw"}, shares(profile.Shifts[opponents.OutcomeDraw])...),
+			append(table.Row{"loss"}, shares(profile.Shifts[opponents.OutcomeLoss])...),
+		},
+	)
+
+	markov := report.Markov
+	if markov.Total() == 0 {
+		fmt.Println("Too few rounds for a Markov predictor to predict you.")
+		return
+	}
+	percent := func(count int) float64 { return 100 * float64(count) / float64(markov.Total()) }
+	fmt.Printf("A Markov predictor countering your most frequent move after each last round would have beaten you "+
+		"in %.0f%% of the %d rounds it predicted, drawn %.0f%% and lost %.0f%%; against a random player it wins 33%%.\n",
+		percent(markov.Wins), markov.Total(), percent(markov.Draws), percent(markov.Losses))
+
+	edge := float64(markov.Wins-markov.Losses) / float64(markov.Total())
+	switch {
+	case edge >= 0.15:
+		fmt.Println("Verdict: very predictable, your habits are easy to exploit.")
+	case edge >= 0.05:
+		fmt.Println("Verdict: somewhat predictable, a patient opponent can exploit your habits.")
+	default:
+		fmt.Println("Verdict: hard to predict from the last round.")
+	}
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestPredictability(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := history.Open()
+	assert.NoError(t, err)
+	// ANA always plays rock against scissors
+	match := model.Match{Players: [2]string{"ANA", "ROBOT"}, Winner: "ANA"}
+	for i := 0; i < 10; i++ {
+		match.Rounds = append(match.Rounds, model.MatchRound{Moves: [2]model.Move{model.Rock, model.Scissors}, Winner: "ANA"})
+	}
+	assert.NoError(t, store.Append(match))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "predictable player",
+			args: []string{"--player", "ana"},
+			wantOuts: []string{
+				"ANA: 10 rounds in the history",
+				"Your moves are rock 100%, paper 0% and scissors 0%: 0.00 bits of entropy out of 1.58 for a random player.",
+				"Win-stay: you keep your move after a win 100% of the time.",
+				"After winning you stay with your winning move 100% of the time (9 rounds).",
+				"would have beaten you in 100% of the 4 rounds it predicted",
+				"Verdict: very predictable",
+			},
+		},
+		{
+			name:     "player without rounds",
+			args:     []string{"--player", "zoe"},
+			wantOuts: []string{"No rounds of ZOE in the history yet."},
+		},
+		{
+			name:    "no player",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Predictability(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
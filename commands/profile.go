@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/profiles"
+)
+
+// Profile lists, shows, changes or deletes the local player profiles.
+func Profile(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: profile list | show NAME | set NAME key=value... | delete NAME")
+	}
+	store, err := profiles.Open()
+	if err != nil {
+		return err
+	}
+	action, args := args[0], args[1:]
+	if action == "list" {
+		return listProfiles(store)
+	}
+	if len(args) == 0 || (action != "set" && len(args) != 1) {
+		return fmt.Errorf("profile %s needs exactly one player name", action)
+	}
+	name := args[0]
+
+	switch action {
+	case "show":
+		profile, err := store.Load(name)
+		if err != nil {
+			return err
+		}
+		displayProfiles([]*profiles.Profile{profile})
+		return nil
+	case "set":
+		return setProfile(store, name, args[1:])
+	case "delete":
+		if err := store.Delete(name); err != nil {
+			return err
+		}
+		fmt.Printf("The profile of %s was deleted.\n", profiles.Name(name))
+		return nil
+	}
+	return fmt.Errorf("unknown profile action %q", action)
+}
+
+func listProfiles(store *profiles.Store) error {
+	list, err := store.List()
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		fmt.Println("No profiles yet.")
+		return nil
+	}
+	displayProfiles(list)
+	return nil
+}
+
+// setProfile applies the key=value settings to the profile.
+func setProfile(store *profiles.Store, name string, settings []string) error {
+	if len(settings) == 0 {
+		return errors.New("profile set needs settings, e.g. difficulty=hard")
+	}
+	profile, err := store.Load(name)
+	if err != nil {
+		return err
+	}
+	for _, setting := range settings {
+		key, value, ok := strings.Cut(setting, "=")
+		if !ok {
+			return fmt.Errorf("invalid setting %q, want key=value", setting)
+		}
+		if key == "theme" && !slices.Contains(cli.Themes(), value) {
+			return fmt.Errorf("unknown theme %q, want one of %s", value, strings.Join(cli.Themes(), ", "))
+		}
+		if err = profile.Set(key, value); err != nil {
+			return err
+		}
+	}
+	if err = store.Save(profile); err != nil {
+		return err
+	}
+	fmt.Printf("The profile of %s was saved.\n", profile.Name)
+	return nil
+}
+
+func displayProfiles(list []*profiles.Profile) {
+	rows := make([]table.Row, 0, len(list))
+	for _, profile := range list {
+		lastPlayed := "-"
+		if !profile.LastPlayed.IsZero() {
+			lastPlayed = profile.LastPlayed.Format(time.DateOnly)
+		}
+		rows = append(rows, table.Row{
+			profile.DisplayName,
+			profile.Ruleset,
+			profile.Difficulty,
+			profile.Theme,
+			profile.Stats.Games,
+			profile.Stats.Wins,
+			profile.Stats.Rounds,
+			lastPlayed,
+		})
+	}
+	cli.DisplayTable(table.Row{"PLAYER", "RULESET", "DIFFICULTY", "THEME", "GAMES", "WON", "ROUNDS", "LAST PLAYED"}, rows)
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/profiles"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestProfile(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := profiles.Open()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Save(profiles.New("Ana")))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name:     "list",
+			args:     []string{"list"},
+			wantOuts: []string{"| Ana    | classic | normal     | red   |     0 |   0 |      0 | -           |"},
+		},
+		{
+			name:     "set",
+			args:     []string{"set", "ana", "difficulty=hard", "theme=blue"},
+			wantOuts: []string{"The profile of ANA was saved."},
+		},
+		{
+			name:     "show",
+			args:     []string{"show", "ANA"},
+			wantOuts: []string{"| Ana    | classic | hard       | blue  |"},
+		},
+		{
+			name:    "unknown theme",
+			args:    []string{"set", "ana", "theme=pink"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid setting",
+			args:    []string{"set", "ana", "difficulty"},
+			wantErr: true,
+		},
+		{
+			name:    "no settings",
+			args:    []string{"set", "ana"},
+			wantErr: true,
+		},
+		{
+			name:     "delete",
+			args:     []string{"delete", "ana"},
+			wantOuts: []string{"The profile of ANA was deleted."},
+		},
+		{
+			name:     "list without profiles",
+			args:     []string{"list"},
+			wantOuts: []string{"No profiles yet."},
+		},
+		{
+			name:    "show a missing profile",
+			args:    []string{"show", "ana"},
+			wantErr: true,
+		},
+		{
+			name:    "no action",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Profile(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
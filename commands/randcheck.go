@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/analysis"
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/game"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/players"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+)
+
+// RandCheck draws moves through the random fallback of the computer and tests that they are uniform and independent.
+func RandCheck(args []string) error {
+	flags := newFlagSet("randcheck")
+	draws := flags.Int("draws", 100000, "number of moves to draw")
+	source := flags.String("random", random.SourcePCG, "source of randomness: crypto, pcg, chacha8 or replay:<file>")
+	seed := flags.Int64("seed", 0, "seed of the pcg and chacha8 sources (default: the clock)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *draws < 100 {
+		return errors.New("at least 100 draws are needed")
+	}
+	seeded := isFlagSet(flags, "seed")
+	if seeded && !random.Seeded(*source) {
+		return fmt.Errorf("the %s source cannot be seeded", *source)
+	}
+	if !seeded {
+		*seed = time.Now().UnixNano()
+	}
+	randomizer, err := random.New(*source, *seed)
+	if err != nil {
+		return err
+	}
+
+	// with nobody winning the last round, the computer always falls back to a random move
+	computer := players.InitComputerPlayer(&game.Throw{}, randomizer)
+	moves := make([]model.Move, *draws)
+	for i := range moves {
+		computer.SetNextMove()
+		moves[i] = computer.GetMove()
+	}
+
+	if random.Seeded(*source) {
+		fmt.Printf("Drew %d moves through the computer's random fallback with the %s source, seed %d\n", *draws, *source, *seed)
+	} else {
+		fmt.Printf("Drew %d moves through the computer's random fallback with the %s source\n", *draws, *source)
+	}
+	results := analysis.CheckRandomness(moves)
+	if !displayRandomness(results) {
+		return errors.New("the random moves failed the randomness check")
+	}
+	return nil
+}
+
+// displayRandomness prints the report and returns whether every test passed.
+func displayRandomness(results []analysis.RandomnessTest) bool {
+	passed := true
+	rows := make([]table.Row, 0, len(results))
+	for _, result := range results {
+		status := "PASS"
+		if !result.Passed() {
+			status = "FAIL"
+			passed = false
+		}
+		rows = append(rows, table.Row{
+			status, result.Name, result.Description, fmt.Sprintf("%.4f", result.Statistic), fmt.Sprintf("%.4f", result.PValue),
+		})
+	}
+	cli.DisplayTable(table.Row{"", "TEST", "MEASURES", "STATISTIC", "P-VALUE"}, rows)
+
+	if passed {
+		fmt.Printf("The random moves look uniform and independent (every p-value is at least %.2f).\n", analysis.Alpha)
+	} else {
+		fmt.Printf("The random moves do not look uniform and independent (a p-value is below %.2f).\n", analysis.Alpha)
+	}
+	return passed
+}
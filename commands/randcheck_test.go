@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestRandCheck(t *testing.T) {
+	cycle := filepath.Join(t.TempDir(), "cycle.txt")
+	assert.NoError(t, os.WriteFile(cycle, []byte("0\n1\n2\n"), 0o644))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "seeded source passes",
+			args: []string{"--draws", "30000", "--seed", "1"},
+			wantOuts: []string{
+				"Drew 30000 moves through the computer's random fallback with the pcg source, seed 1",
+				"| PASS | uniformity ",
+				"The random moves look uniform and independent",
+			},
+		},
+		{
+			name:    "replayed cycle fails",
+			args:    []string{"--draws", "30000", "--random", "replay:" + cycle},
+			wantErr: true,
+			wantOuts: []string{
+				"| FAIL | runs ",
+				"The random moves do not look uniform and independent",
+			},
+		},
+		{
+			name:    "seeded crypto source",
+			args:    []string{"--random", "crypto", "--seed", "1"},
+			wantErr: true,
+		},
+		{
+			name:    "too few draws",
+			args:    []string{"--draws", "10"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = RandCheck(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
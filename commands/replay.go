@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/game"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/players"
+)
+
+// replayInput is where the playback controls are read from, replaced by tests.
+var replayInput io.Reader = os.Stdin
+
+// Replay plays a match of the history again, or lists the matches when none is given.
+func Replay(args []string) error {
+	flags := newFlagSet("replay")
+	speed := flags.Float64("speed", 1, "playback speed, 2 being twice as fast")
+	paused := flags.Bool("paused", false, "start paused, to step through the rounds")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *speed <= 0 {
+		return errors.New("speed must be positive")
+	}
+
+	store, err := history.Open()
+	if err != nil {
+		return err
+	}
+	matches, err := store.Matches()
+	if err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		listMatches(matches)
+		return nil
+	}
+	match, err := findMatch(matches, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	replay := game.InitReplay(readControls(replayInput), *speed)
+	if *paused {
+		replay.Pause()
+	}
+	replay.Play(context.Background(), match, players.InitReplayPlayer(match, 0), players.InitReplayPlayer(match, 1))
+	return nil
+}
+
+// findMatch returns the match of the id, its position in the history from 1, or from the end when negative.
+func findMatch(matches []model.Match, id string) (model.Match, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return model.Match{}, fmt.Errorf("invalid match id %q", id)
+	}
+	if n < 0 {
+		n += len(matches) + 1
+	}
+	if n < 1 || n > len(matches) {
+		return model.Match{}, fmt.Errorf("no match %s, the history has %d", id, len(matches))
+	}
+	return matches[n-1], nil
+}
+
+// readControls sends the lines of the input, until it is over.
+func readControls(input io.Reader) <-chan string {
+	controls := make(chan string)
+	go func() {
+		defer close(controls)
+		scanner := bufio.NewScanner(input)
+		for scanner.Scan() {
+			controls <- strings.TrimSpace(scanner.Text())
+		}
+	}()
+	return controls
+}
+
+func listMatches(matches []model.Match) {
+	if len(matches) == 0 {
+		fmt.Println("No matches in the history yet.")
+		return
+	}
+	rows := make([]table.Row, 0, len(matches))
+	for i, match := range matches {
+		rows = append(rows, table.Row{
+			i + 1,
+			match.EndedAt.Format(time.DateTime),
+			match.Players[0] + " vs " + match.Players[1],
+			match.Winner,
+			len(match.Rounds),
+		})
+	}
+	cli.DisplayTable(table.Row{"ID", "ENDED", "PLAYERS", "WINNER", "ROUNDS"}, rows)
+}
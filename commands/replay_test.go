@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestReplay(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	restoreTimeSpan := testutils.IgnoreSleep()
+	defer restoreTimeSpan()
+
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name:     "empty history",
+			wantOuts: []string{"No matches in the history yet."},
+		},
+		{
+			name:    "no match",
+			args:    []string{"1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runReplay(t, tt.args, tt.input, tt.wantErr, tt.wantOuts)
+		})
+	}
+
+	store, err := history.Open()
+	assert.NoError(t, err)
+	ended := time.Date(2024, 5, 1, 10, 30, 0, 0, time.Local)
+	assert.NoError(t, store.Append(model.Match{
+		Players: [2]string{"ANA", "ROBOT"},
+		Winner:  "ROBOT",
+		Rounds:  []model.MatchRound{{Moves: [2]model.Move{model.Rock, model.Paper}, Winner: "ROBOT"}},
+		EndedAt: ended,
+	}))
+	assert.NoError(t, store.Append(model.Match{
+		Players:      [2]string{"ANA", "ROBOT"},
+		Winner:       "ANA",
+		WinningScore: 1,
+		Rounds: []model.MatchRound{
+			{Moves: [2]model.Move{model.Paper, model.Paper}},
+			{Moves: [2]model.Move{model.Scissors, model.Paper}, Winner: "ANA"},
+		},
+		EndedAt: ended.Add(time.Hour),
+	}))
+
+	tests = []struct {
+		name     string
+		args     []string
+		input    string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "list",
+			wantOuts: []string{
+				"|  1 | 2024-05-01 10:30:00 | ANA vs ROBOT | ROBOT  |      1 |",
+				"|  2 | 2024-05-01 11:30:00 | ANA vs ROBOT | ANA    |      2 |",
+			},
+		},
+		{
+			name: "last match",
+			args: []string{"--speed", "4", "--", "-1"},
+			wantOuts: []string{
+				"It's a draw!",
+				"Scissors beats Paper, \x1b[1;31mANA\x1b[0m wins the round!",
+				"Replay of round 2/2 at speed x4",
+			},
+		},
+		{
+			name:     "paused",
+			args:     []string{"--paused", "1"},
+			input:    "n\nq\n",
+			wantOuts: []string{"Paper beats Rock", "Replay of round 1/1"},
+		},
+		{
+			name:    "invalid id",
+			args:    []string{"last"},
+			wantErr: true,
+		},
+		{
+			name:    "out of the history",
+			args:    []string{"3"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid speed",
+			args:    []string{"--speed", "0", "1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runReplay(t, tt.args, tt.input, tt.wantErr, tt.wantOuts)
+		})
+	}
+}
+
+func runReplay(t *testing.T, args []string, input string, wantErr bool, wantOuts []string) {
+	replayInput = strings.NewReader(input)
+	var err error
+	out, captureErr := testutils.CaptureStdout(func() {
+		err = Replay(args)
+	})
+	assert.NoError(t, captureErr)
+	assert.Equal(t, wantErr, err != nil, "error: %v", err)
+	for _, want := range wantOuts {
+		assert.Contains(t, out, want)
+	}
+}
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
+)
+
+// Season lists, shows, schedules or removes the seasons of the ladder.
+func Season(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: season list | show NAME | add NAME START END | delete NAME")
+	}
+	matches, ratings, err := loadRatings()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	action, args := args[0], args[1:]
+
+	switch action {
+	case "list":
+		ratings.Advance(now)
+		if err = rating.Save(ratings); err != nil {
+			return err
+		}
+		listSeasons(ratings, now)
+		return nil
+	case "show":
+		if len(args) != 1 {
+			return errors.New("season show needs exactly one season name")
+		}
+		ratings.Advance(now)
+		if err = rating.Save(ratings); err != nil {
+			return err
+		}
+		standings, ok := ratings.Standings(args[0], now)
+		if !ok {
+			return fmt.Errorf("season %s has not started", args[0])
+		}
+		displayStandings(standings)
+		return nil
+	case "add":
+		if len(args) != 3 {
+			return errors.New("season add needs a name, a start and an end date, e.g. spring 2024-03-01 2024-05-31")
+		}
+		season := rating.Season{Name: args[0]}
+		if season.Start, err = time.ParseInLocation(time.DateOnly, args[1], time.Local); err != nil {
+			return fmt.Errorf("invalid start date: %w", err)
+		}
+		end, err := time.ParseInLocation(time.DateOnly, args[2], time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid end date: %w", err)
+		}
+		// the end date is included
+		season.End = end.AddDate(0, 0, 1)
+		if err = ratings.AddSeason(season); err != nil {
+			return err
+		}
+		fmt.Printf("Season %s scheduled from %s to %s.\n", season.Name, args[1], args[2])
+	case "delete":
+		if len(args) != 1 {
+			return errors.New("season delete needs exactly one season name")
+		}
+		if err = ratings.RemoveSeason(args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("Season %s was deleted.\n", args[0])
+	default:
+		return fmt.Errorf("unknown season action %q", action)
+	}
+
+	// the ratings are replayed with the new schedule
+	ratings = rating.Compute(matches, ratings.Config, ratings.Seasons)
+	ratings.Advance(now)
+	return rating.Save(ratings)
+}
+
+// loadRatings reads the match history and the saved ratings, or new ones when there are none yet.
+func loadRatings() ([]model.Match, *rating.Table, error) {
+	store, err := history.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	matches, err := store.Matches()
+	if err != nil {
+		return nil, nil, err
+	}
+	ratings, err := rating.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	if ratings == nil {
+		ratings = rating.Compute(matches, rating.DefaultConfig(), nil)
+	}
+	return matches, ratings, nil
+}
+
+func listSeasons(ratings *rating.Table, now time.Time) {
+	if len(ratings.Seasons) == 0 {
+		fmt.Println("No seasons yet.")
+		return
+	}
+	rows := make([]table.Row, 0, len(ratings.Seasons))
+	for _, season := range ratings.Seasons {
+		status, games, champion := "upcoming", "-", "-"
+		if standings, ok := ratings.Standings(season.Name, now); ok {
+			status, games = "finished", fmt.Sprint(standings.Games)
+			if ratings.Current != nil && ratings.Current.Season.Name == season.Name {
+				status = "current"
+			}
+			if len(standings.Players) > 0 {
+				champion = standings.Players[0].Name
+			}
+		}
+		rows = append(rows, table.Row{season.Name, season.Start.Format(time.DateOnly), seasonEnd(season), status, games, champion})
+	}
+	cli.DisplayTable(table.Row{"SEASON", "START", "END", "STATUS", "GAMES", "LEADER"}, rows)
+}
+
+func displayStandings(standings rating.Standings) {
+	season := standings.Season
+	fmt.Printf("Season %s, from %s to %s: %d games, %d rounds.\n",
+		season.Name, season.Start.Format(time.DateOnly), seasonEnd(season), standings.Games, standings.Rounds)
+	if len(standings.Players) == 0 {
+		fmt.Println("Nobody played in this season.")
+		return
+	}
+	rows := make([]table.Row, 0, len(standings.Players))
+	for i, player := range standings.Players {
+		kind := "strategy"
+		if player.Human {
+			kind = "human"
+		}
+		rows = append(rows, table.Row{
+			i + 1,
+			player.Name,
+			kind,
+			fmt.Sprintf("%.0f", player.Rating.Rating),
+			fmt.Sprintf("±%.0f", 2*player.Deviation),
+			player.SeasonGames,
+			player.SeasonWins,
+		})
+	}
+	cli.DisplayTable(table.Row{"RANK", "PLAYER", "KIND", "RATING", "95%", "GAMES", "WON"}, rows)
+}
+
+// seasonEnd returns the last day of the season.
+func seasonEnd(season rating.Season) string {
+	return season.End.AddDate(0, 0, -1).Format(time.DateOnly)
+}
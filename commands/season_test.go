@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestSeason(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := history.Open()
+	assert.NoError(t, err)
+	round := model.MatchRound{Moves: [2]model.Move{model.Paper, model.Rock}, Winner: "ANA"}
+	for _, ended := range []string{"2024-03-10", "2024-03-20", "2024-06-10"} {
+		at, err := time.ParseInLocation(time.DateOnly, ended, time.Local)
+		assert.NoError(t, err)
+		assert.NoError(t, store.Append(model.Match{
+			Players:    [2]string{"ANA", "ROBOT"},
+			Strategies: [2]string{"", "heuristic"},
+			Winner:     "ANA",
+			Rounds:     []model.MatchRound{round, round, round},
+			EndedAt:    at,
+		}))
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name:     "no seasons",
+			args:     []string{"list"},
+			wantOuts: []string{"No seasons yet."},
+		},
+		{
+			name:     "add",
+			args:     []string{"add", "spring", "2024-03-01", "2024-05-31"},
+			wantOuts: []string{"Season spring scheduled from 2024-03-01 to 2024-05-31."},
+		},
+		{
+			name:     "add a future season",
+			args:     []string{"add", "future", "2999-01-01", "2999-03-31"},
+			wantOuts: []string{"Season future scheduled"},
+		},
+		{
+			name:    "overlapping season",
+			args:    []string{"add", "april", "2024-04-01", "2024-04-30"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid date",
+			args:    []string{"add", "summer", "2024-06-01", "June 30"},
+			wantErr: true,
+		},
+		{
+			name: "list",
+			args: []string{"list"},
+			wantOuts: []string{
+				"| spring | 2024-03-01 | 2024-05-31 | finished | 2     | ANA    |",
+				"| future | 2999-01-01 | 2999-03-31 | upcoming | -     | -      |",
+			},
+		},
+		{
+			name: "show",
+			args: []string{"show", "spring"},
+			wantOuts: []string{
+				"Season spring, from 2024-03-01 to 2024-05-31: 2 games, 6 rounds.",
+				"|    1 | ANA       | human    |",
+				"|    2 | heuristic | strategy |",
+			},
+		},
+		{
+			name:    "not started",
+			args:    []string{"show", "future"},
+			wantErr: true,
+		},
+		{
+			name:     "delete",
+			args:     []string{"delete", "future"},
+			wantOuts: []string{"Season future was deleted."},
+		},
+		{
+			name:    "unknown season",
+			args:    []string{"delete", "future"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			args:    []string{"rename", "spring"},
+			wantErr: true,
+		},
+		{
+			name:    "no action",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Season(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+
+	ratings, err := rating.Load()
+	assert.NoError(t, err)
+	assert.Len(t, ratings.Seasons, 1)
+	assert.Len(t, ratings.Archive, 1, "the spring is archived")
+	assert.Equal(t, 3, ratings.Players["human:ANA"].Games)
+}
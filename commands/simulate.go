@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+// Simulate plays headless games between two strategies and prints the results of player 1.
+func Simulate(args []string) error {
+	flags := newFlagSet("simulate")
+	cfg := simulation.Config{}
+	flags.StringVar(&cfg.P1, "p1", "", "strategy of player 1")
+	flags.StringVar(&cfg.P2, "p2", "", "strategy of player 2")
+	flags.IntVar(&cfg.Games, "games", 1000, "number of games")
+	flags.Int64Var(&cfg.Seed, "seed", 0, "seed of the games (default: random, printed in the report)")
+	flags.IntVar(&cfg.WinningScore, "points", 3, "points needed to win a game")
+	flags.IntVar(&cfg.MaxRounds, "max-rounds", 1000, "rounds after which a game is a draw")
+	flags.IntVar(&cfg.Workers, "workers", 0, "goroutines playing games (default: GOMAXPROCS); results do not depend on it")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if cfg.P1 == "" || cfg.P2 == "" {
+		return errors.New("both --p1 and --p2 strategies are required")
+	}
+	if !isFlagSet(flags, "seed") {
+		cfg.Seed = time.Now().UnixNano()
+	}
+
+	result, err := simulation.Run(cfg)
+	if err != nil {
+		return err
+	}
+	displaySimulation(result)
+	return nil
+}
+
+func displaySimulation(result simulation.Result) {
+	cfg := result.Config
+	fmt.Printf("%s (p1) vs %s (p2): %d games to %d points, seed %d\n",
+		cfg.P1, cfg.P2, cfg.Games, cfg.WinningScore, cfg.Seed)
+
+	gameRate, gameCI := result.WinRate()
+	roundRate, roundCI := result.RoundWinRate()
+	cli.DisplayTable(
+		table.Row{"", "P1 WINS", "DRAWS", "P1 LOSSES", "P1 WIN RATE", "95% CI"},
+		[]table.Row{
+			outcomeRow("games", result.Games, gameRate, gameCI),
+			outcomeRow("rounds", result.Rounds, roundRate, roundCI),
+		},
+	)
+
+	mean, meanCI := result.MeanGameLength()
+	fmt.Printf("mean game length: %.2f rounds (95%% CI %.2f - %.2f)\n", mean, meanCI.Low, meanCI.High)
+}
+
+func outcomeRow(label string, outcome simulation.Outcome, rate float64, ci simulation.Interval) table.Row {
+	return table.Row{
+		label,
+		outcome.Wins,
+		outcome.Draws,
+		outcome.Losses,
+		fmt.Sprintf("%.1f%%", 100*rate),
+		fmt.Sprintf("%.1f%% - %.1f%%", 100*ci.Low, 100*ci.High),
+	}
+}
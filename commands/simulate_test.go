@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "seeded simulation",
+			args: []string{"--p1", "rock", "--p2", "cycler", "--games", "10", "--seed", "7"},
+			wantOuts: []string{
+				"rock (p1) vs cycler (p2): 10 games to 3 points, seed 7",
+				"| games  |       0 |     0 |        10 |",
+				"mean game length: 8.00 rounds",
+			},
+		},
+		{
+			name:    "missing strategy",
+			args:    []string{"--p1", "rock"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown strategy",
+			args:    []string{"--p1", "rock", "--p2", "lizard"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Simulate(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+// Stats reports the results of a player, or of every human player one after the other, from the
+// match history.
+func Stats(args []string) error {
+	flags := newFlagSet("stats")
+	player := flags.String("player", "", "name of the player (default: every human player)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	name := playerName(*player)
+
+	store, err := history.Open()
+	if err != nil {
+		return err
+	}
+	matches, err := store.Matches()
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		humans := history.Humans(matches)
+		if len(humans) == 0 {
+			fmt.Println("No games in the history yet.")
+		}
+		for i, human := range humans {
+			if i > 0 {
+				fmt.Println()
+			}
+			displayStats(history.Summarize(matches, human))
+		}
+		return nil
+	}
+	stats := history.Summarize(matches, name)
+	if stats.Games.Total() == 0 {
+		fmt.Printf("No games of %s in the history yet.\n", name)
+		return nil
+	}
+	displayStats(stats)
+	return nil
+}
+
+func displayStats(stats history.Stats) {
+	fmt.Println(stats.Player)
+	cli.DisplayTable(
+		table.Row{"", "PLAYED", "WON", "DRAWN", "LOST"},
+		[]table.Row{
+			append(table.Row{"games"}, outcomeRates(stats.Games)...),
+			append(table.Row{"rounds"}, outcomeRates(stats.Rounds)...),
+		},
+	)
+	cli.DisplayTable(
+		table.Row{"", "ROCK", "PAPER", "SCISSORS"},
+		[]table.Row{append(table.Row{"moves"}, shares(stats.Moves)...)},
+	)
+	cli.DisplayTable(
+		table.Row{"ROUNDS PER GAME", "LONGEST ROUND WIN STREAK", "LONGEST ROUND LOSS STREAK", "LONGEST GAME WIN STREAK"},
+		[]table.Row{{
+			fmt.Sprintf("%.1f", stats.AverageGameLength()),
+			stats.LongestWinStreak,
+			stats.LongestLossStreak,
+			stats.LongestGameStreak,
+		}},
+	)
+
+	rows := make([]table.Row, 0, len(stats.Opponents))
+	for _, opponent := range stats.Opponents {
+		row := table.Row{opponent.Strategy, opponent.Games.Total(), opponent.Games.Wins, opponent.Games.Losses}
+		rows = append(rows, append(row, outcomeRates(opponent.Rounds)[1:]...))
+	}
+	cli.DisplayTable(table.Row{"OPPONENT", "GAMES", "WON", "LOST", "ROUNDS WON", "DRAWN", "LOST"}, rows)
+}
+
+// outcomeRates formats the total of the outcome, then its wins, draws and losses with their share.
+func outcomeRates(outcome simulation.Outcome) table.Row {
+	total := outcome.Total()
+	row := table.Row{total}
+	for _, count := range []int{outcome.Wins, outcome.Draws, outcome.Losses} {
+		if total == 0 {
+			row = append(row, "-")
+			continue
+		}
+		row = append(row, fmt.Sprintf("%d (%.0f%%)", count, 100*float64(count)/float64(total)))
+	}
+	return row
+}
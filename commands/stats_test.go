@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestStats(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	store, err := history.Open()
+	assert.NoError(t, err)
+	assert.NoError(t, store.Append(model.Match{
+		Players:    [2]string{"ANA", "ROBOT"},
+		Strategies: [2]string{"", "heuristic"},
+		Winner:     "ANA",
+		Rounds: []model.MatchRound{
+			{Moves: [2]model.Move{model.Rock, model.Rock}},
+			{Moves: [2]model.Move{model.Paper, model.Rock}, Winner: "ANA"},
+		},
+	}))
+	assert.NoError(t, store.Append(model.Match{
+		Players:    [2]string{"PAUL", "ROBOT"},
+		Strategies: [2]string{"", "heuristic"},
+		Winner:     "ROBOT",
+		Rounds:     []model.MatchRound{{Moves: [2]model.Move{model.Rock, model.Paper}, Winner: "ROBOT"}},
+	}))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "player",
+			args: []string{"--player", "ana"},
+			wantOuts: []string{
+				"ANA\n",
+				"| games  |      1 | 1 (100%) | 0 (0%)  | 0 (0%) |",
+				"| rounds |      2 | 1 (50%)  | 1 (50%) | 0 (0%) |",
+				"| moves | 50%  | 50%   | 0%       |",
+				"| 2.0             |                        1 |                         0 |                       1 |",
+				"| heuristic |     1 |   1 |    0 | 1 (50%)    | 1 (50%) | 0 (0%) |",
+			},
+		},
+		{
+			name:     "every player in their own section",
+			wantOuts: []string{"ANA\n", "PAUL\n", "| heuristic |     1 |   0 |    1 |"},
+		},
+		{
+			name:     "unknown player",
+			args:     []string{"--player", "zoe"},
+			wantOuts: []string{"No games of ZOE in the history yet."},
+		},
+		{
+			name:    "unknown flag",
+			args:    []string{"--games", "3"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Stats(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
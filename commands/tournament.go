@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/tournament"
+)
+
+// Tournament plays a round-robin between strategies and prints the standings and head-to-head matrix.
+func Tournament(args []string) error {
+	flags := newFlagSet("tournament")
+	cfg := tournament.Config{}
+	var list specsFlag
+	flags.Var(&list, "strategy", "strategy to enter (repeatable, default: every strategy that needs no training)")
+	flags.IntVar(&cfg.Games, "games", 100, "games played by each pairing")
+	flags.Int64Var(&cfg.Seed, "seed", 0, "seed of the tournament (default: random, printed in the report)")
+	flags.IntVar(&cfg.WinningScore, "points", 3, "points needed to win a game")
+	flags.IntVar(&cfg.MaxRounds, "max-rounds", 1000, "rounds after which a game is a draw")
+	csvPath := flags.String("csv", "", "file to export the standings to as CSV")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if len(list) > 0 {
+		cfg.Strategies = list
+	}
+	if !isFlagSet(flags, "seed") {
+		cfg.Seed = time.Now().UnixNano()
+	}
+
+	result, err := tournament.Run(cfg)
+	if err != nil {
+		return err
+	}
+	displayTournament(result)
+
+	if *csvPath != "" {
+		return writeTournamentCSV(result, *csvPath)
+	}
+	return nil
+}
+
+func displayTournament(result tournament.Result) {
+	cfg := result.Config
+	fmt.Printf("Round-robin of %d strategies, %d games per pairing to %d points, seed %d\n",
+		len(result.Standings), cfg.Games, cfg.WinningScore, cfg.Seed)
+
+	rows := make([]table.Row, 0, len(result.Standings))
+	for i, s := range result.Standings {
+		rows = append(rows, table.Row{
+			i + 1, s.Strategy, s.Points, s.Won, s.Drawn, s.Lost, s.GamesWon, s.GamesLost, fmt.Sprintf("%+d", s.Margin()),
+		})
+	}
+	cli.DisplayTable(table.Row{"#", "STRATEGY", "POINTS", "W", "D", "L", "GAMES WON", "GAMES LOST", "MARGIN"}, rows)
+
+	fmt.Println("Head-to-head: share of games the row strategy won against the column strategy")
+	header := table.Row{""}
+	for i := range result.Standings {
+		header = append(header, i+1)
+	}
+	rows = make([]table.Row, 0, len(result.Standings))
+	for i, s := range result.Standings {
+		row := table.Row{fmt.Sprintf("%d %s", i+1, s.Strategy)}
+		for _, opponent := range result.Standings {
+			if opponent.Strategy == s.Strategy {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.0f%%", 100*result.WinRate(s.Strategy, opponent.Strategy)))
+		}
+		rows = append(rows, row)
+	}
+	cli.DisplayTable(header, rows)
+}
+
+func writeTournamentCSV(result tournament.Result, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = result.WriteCSV(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
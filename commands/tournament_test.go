@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestTournament(t *testing.T) {
+	csvPath := filepath.Join(t.TempDir(), "standings.csv")
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+		wantCSV  bool
+	}{
+		{
+			name: "seeded tournament exported to csv",
+			args: []string{"--strategy", "rock", "--strategy", "cycler", "--strategy", "debruijn", "--games", "5", "--seed", "1", "--csv", csvPath},
+			wantOuts: []string{
+				"Round-robin of 3 strategies, 5 games per pairing to 3 points, seed 1",
+				"| 1 | cycler   |      6 | 2 | 0 | 0 |        10 |          0 | +10    |",
+				"| 3 rock     | 0% | 0%   | -    |",
+			},
+			wantCSV: true,
+		},
+		{
+			name:     "parameterised strategies",
+			args:     []string{"--strategy", "biased:5,3,2", "--strategy", "human:rock=0.9,stay=0", "--games", "2", "--seed", "1"},
+			wantOuts: []string{"Round-robin of 2 strategies", "biased:5,3,2", "human:rock=0.9,stay=0"},
+		},
+		{
+			name:    "duplicate strategy",
+			args:    []string{"--strategy", "rock", "--strategy", "cycler", "--strategy", "rock"},
+			wantErr: true,
+		},
+		{
+			name:    "single strategy",
+			args:    []string{"--strategy", "rock"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Tournament(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+			if tt.wantCSV {
+				content, err := os.ReadFile(csvPath)
+				assert.NoError(t, err)
+				assert.Contains(t, string(content), "1,cycler,6,2,0,0,10,0,10,,1.0000,1.0000\n")
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+	"github.com/yuripiffer/rock-paper-scissors/training"
+)
+
+// Train learns a Q-learning table against built-in strategies and saves it to the data directory.
+func Train(args []string) error {
+	flags := newFlagSet("train")
+	cfg := training.Config{}
+	var list specsFlag
+	flags.Var(&list, "opponent", "strategy to train against (repeatable, default: every strategy that needs no training)")
+	flags.IntVar(&cfg.Episodes, "episodes", 20000, "number of episodes, each against the next opponent")
+	flags.IntVar(&cfg.Rounds, "rounds", 50, "rounds of an episode")
+	flags.IntVar(&cfg.Memory, "memory", 2, fmt.Sprintf("previous rounds a state is made of (0-%d)", training.MaxMemory))
+	flags.Float64Var(&cfg.Alpha, "alpha", 0.1, "learning rate")
+	flags.Float64Var(&cfg.Gamma, "gamma", 0.9, "discount of future rewards")
+	flags.Float64Var(&cfg.Epsilon, "epsilon", 0.1, "exploration probability")
+	flags.Int64Var(&cfg.Seed, "seed", 0, "seed of the training (default: random, printed in the report)")
+	out := flags.String("out", "", "file to save the table to (default: "+strategies.QTableFile+" in the data directory)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	cfg.Opponents = strategies.Zoo()
+	if len(list) > 0 {
+		cfg.Opponents = list
+	}
+	if !isFlagSet(flags, "seed") {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	path := *out
+	if path == "" {
+		var err error
+		if path, err = storage.Path(strategies.QTableFile); err != nil {
+			return err
+		}
+	}
+
+	result, err := training.Train(cfg)
+	if err != nil {
+		return err
+	}
+	if err = strategies.SaveQTable(path, result.Table); err != nil {
+		return err
+	}
+
+	rounds := result.Wins + result.Draws + result.Losses
+	fmt.Printf("Trained %d episodes of %d rounds against %s, seed %d\n",
+		cfg.Episodes, cfg.Rounds, strings.Join(cfg.Opponents, ", "), cfg.Seed)
+	fmt.Printf("training rounds: %d won, %d drawn, %d lost (%.1f%% won)\n",
+		result.Wins, result.Draws, result.Losses, 100*float64(result.Wins)/float64(rounds))
+	fmt.Printf("Q-table saved to %s\n", path)
+	return nil
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestTrain(t *testing.T) {
+	dataHome := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", dataHome)
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name: "table saved to the data directory",
+			args: []string{"--opponent", "rock", "--opponent", "cycler", "--episodes", "10", "--rounds", "10", "--seed", "1"},
+			wantOuts: []string{
+				"Trained 10 episodes of 10 rounds against rock, cycler, seed 1",
+				"Q-table saved to " + filepath.Join(dataHome, "rock-paper-scissors", strategies.QTableFile),
+			},
+		},
+		{
+			name:    "invalid learning rate",
+			args:    []string{"--alpha", "2"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Train(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+
+	_, err := strategies.New("qlearning", nil)
+	assert.NoError(t, err, "the qlearning strategy loads the trained table")
+}
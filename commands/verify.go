@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/fairness"
+)
+
+// Verify checks that every move revealed in a transcript matches the commitment written on an
+// earlier line, before the opponent chose.
+func Verify(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: verify <transcript>")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	entries, err := fairness.ReadTranscript(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("%s: no rounds to verify", args[0])
+	}
+
+	checks := fairness.CheckTranscript(entries)
+	failed := 0
+	rows := make([]table.Row, 0, len(checks))
+	for _, check := range checks {
+		status := "PASS"
+		if check.Problem != "" {
+			status = "FAIL"
+			failed++
+		}
+		rows = append(rows, table.Row{status, check.Round, check.Player, check.Move, check.Opponent, check.Commitment, check.Problem})
+	}
+	cli.DisplayTable(table.Row{"", "ROUND", "PLAYER", "MOVE", "OPPONENT", "COMMITMENT", "PROBLEM"}, rows)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d rounds do not match a commitment published before them", failed, len(checks))
+	}
+	if len(checks) == 0 {
+		return fmt.Errorf("%s: no revealed rounds to verify", args[0])
+	}
+	fmt.Printf("All %d rounds match the commitments published before the opponent chose.\n", len(checks))
+	return nil
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/fairness"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func TestVerify(t *testing.T) {
+	dir := t.TempDir()
+	writeTranscript := func(name string, entries ...fairness.Entry) string {
+		buf := &bytes.Buffer{}
+		for _, entry := range entries {
+			assert.NoError(t, fairness.WriteEntry(buf, entry))
+		}
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+		return path
+	}
+	commit := fairness.NewCommitEntry(1, "ROBOT", fairness.Commit(model.Rock, "ab12"))
+	honest := fairness.NewRevealEntry(1, "ROBOT", model.Rock, "ab12", model.Paper)
+	changed := fairness.NewRevealEntry(2, "ROBOT", model.Paper, "cd34", model.Rock)
+	valid := writeTranscript("valid.jsonl", commit, honest)
+	tampered := writeTranscript("tampered.jsonl", commit, honest,
+		fairness.NewCommitEntry(2, "ROBOT", fairness.Commit(model.Rock, "cd34")), changed)
+	// the commitment of the move was only written after the round
+	late := writeTranscript("late.jsonl", honest, commit)
+	unrevealed := writeTranscript("unrevealed.jsonl", commit)
+	empty := writeTranscript("empty.jsonl")
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		wantOuts []string
+	}{
+		{
+			name:     "valid transcript",
+			args:     []string{valid},
+			wantOuts: []string{"| PASS |     1 | ROBOT  | Rock |", "All 1 rounds match the commitments"},
+		},
+		{
+			name:     "changed move",
+			args:     []string{tampered},
+			wantErr:  true,
+			wantOuts: []string{"| FAIL |     2 | ROBOT  | Paper | Rock     |", "the move does not match the commitment"},
+		},
+		{
+			name:     "commitment written after the round",
+			args:     []string{late},
+			wantErr:  true,
+			wantOuts: []string{"| FAIL |     1 | ROBOT  | Rock | Paper    |", "no earlier commitment"},
+		},
+		{
+			name:    "nothing revealed",
+			args:    []string{unrevealed},
+			wantErr: true,
+		},
+		{
+			name:    "empty transcript",
+			args:    []string{empty},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			args:    []string{filepath.Join(dir, "missing.jsonl")},
+			wantErr: true,
+		},
+		{
+			name:    "no file",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			out, captureErr := testutils.CaptureStdout(func() {
+				err = Verify(tt.args)
+			})
+			assert.NoError(t, captureErr)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+		})
+	}
+}
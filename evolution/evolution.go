@@ -0,0 +1,228 @@
+// Package evolution discovers rule-based strategies with a genetic algorithm.
+package evolution
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+const (
+	// elites are the best individuals copied unchanged into the next generation.
+	elites = 2
+	// tournamentSize is the number of individuals competing to be selected as a parent.
+	tournamentSize = 3
+	// maxWeight is the largest weight of a move in a rule.
+	maxWeight = 9
+)
+
+// Config configures an evolution.
+type Config struct {
+	// Opponents are the strategy specs every individual plays against.
+	Opponents   []string
+	Population  int
+	Generations int
+	// Games is the number of games played against each opponent to measure the fitness.
+	Games        int
+	WinningScore int
+	MaxRounds    int
+	// MaxRules is the largest number of rules of a program.
+	MaxRules int
+	// MutationRate is the probability of mutating each rule of a child.
+	MutationRate float64
+	Seed         int64
+}
+
+// Individual is a program and its fitness: games won minus games lost against the opponents,
+// per game, between -1 and 1.
+type Individual struct {
+	Program *strategies.Program
+	Fitness float64
+}
+
+// Progress is called after each generation with its best individual.
+type Progress func(generation int, best Individual)
+
+// Evolve evolves a population of programs and returns the best individual of the last generation.
+// Every individual of a generation faces the same random numbers, so fitness differences come from
+// the programs only, and the same seed reproduces the same evolution.
+func Evolve(cfg Config, progress Progress) (Individual, error) {
+	if err := validate(cfg); err != nil {
+		return Individual{}, err
+	}
+	randomizer := random.NewPCG(uint64(cfg.Seed), 0)
+
+	population := make([]Individual, cfg.Population)
+	for i := range population {
+		population[i] = Individual{Program: randomProgram(randomizer, cfg.MaxRules)}
+	}
+
+	for generation := 1; ; generation++ {
+		for i := range population {
+			fitness, err := evaluate(cfg, population[i].Program, uint64(generation))
+			if err != nil {
+				return Individual{}, err
+			}
+			population[i].Fitness = fitness
+		}
+		slices.SortStableFunc(population, func(a, b Individual) int {
+			switch {
+			case a.Fitness > b.Fitness:
+				return -1
+			case a.Fitness < b.Fitness:
+				return 1
+			}
+			return 0
+		})
+		if progress != nil {
+			progress(generation, population[0])
+		}
+		if generation == cfg.Generations {
+			return population[0], nil
+		}
+
+		next := make([]Individual, 0, cfg.Population)
+		for i := 0; i < elites && i < len(population); i++ {
+			next = append(next, population[i])
+		}
+		for len(next) < cfg.Population {
+			child := crossover(randomizer, selectParent(randomizer, population), selectParent(randomizer, population), cfg.MaxRules)
+			mutate(randomizer, child, cfg.MutationRate, cfg.MaxRules)
+			next = append(next, Individual{Program: child})
+		}
+		population = next
+	}
+}
+
+func validate(cfg Config) error {
+	switch {
+	case len(cfg.Opponents) == 0:
+		return errors.New("at least one opponent is required")
+	case cfg.Population < elites+1 || cfg.Generations < 1 || cfg.Games < 1:
+		return errors.New("population must be above 2, generations and games must be positive")
+	case cfg.WinningScore < 1 || cfg.MaxRounds < 1 || cfg.MaxRules < 1:
+		return errors.New("winning score, max rounds and max rules must be positive")
+	case cfg.MutationRate < 0 || cfg.MutationRate > 1:
+		return errors.New("mutation rate must be between 0 and 1")
+	}
+	return nil
+}
+
+// evaluate plays the program against every opponent with the random numbers of the generation.
+func evaluate(cfg Config, program *strategies.Program, generation uint64) (float64, error) {
+	randomizer := random.NewPCG(uint64(cfg.Seed), generation)
+	individual := strategies.NewProgramStrategy(randomizer, program)
+	margin := 0
+	for _, spec := range cfg.Opponents {
+		opponent, err := strategies.New(spec, randomizer)
+		if err != nil {
+			return 0, err
+		}
+		for i := 0; i < cfg.Games; i++ {
+			individual.Reset()
+			opponent.Reset()
+			switch simulation.PlayGame(individual, opponent, cfg.WinningScore, cfg.MaxRounds).Winner {
+			case 1:
+				margin++
+			case 2:
+				margin--
+			}
+		}
+	}
+	return float64(margin) / float64(cfg.Games*len(cfg.Opponents)), nil
+}
+
+// selectParent returns the fittest of tournamentSize random individuals.
+func selectParent(randomizer model.Randomizer, population []Individual) *strategies.Program {
+	best := population[randomizer.Intn(len(population))]
+	for i := 1; i < tournamentSize; i++ {
+		if candidate := population[randomizer.Intn(len(population))]; candidate.Fitness > best.Fitness {
+			best = candidate
+		}
+	}
+	return best.Program
+}
+
+// crossover joins the first rules of one parent with the last rules of the other.
+func crossover(randomizer model.Randomizer, a, b *strategies.Program, maxRules int) *strategies.Program {
+	cutA := randomizer.Intn(len(a.Rules) + 1)
+	cutB := randomizer.Intn(len(b.Rules) + 1)
+	rules := append(slices.Clone(a.Rules[:cutA]), b.Rules[cutB:]...)
+	if len(rules) == 0 {
+		rules = append(rules, randomRule(randomizer))
+	}
+	if len(rules) > maxRules {
+		rules = rules[:maxRules]
+	}
+	return &strategies.Program{Version: strategies.ProgramVersion, Rules: rules}
+}
+
+// mutate changes each rule with the mutation rate, and may add or remove a rule.
+func mutate(randomizer model.Randomizer, program *strategies.Program, rate float64, maxRules int) {
+	for i := range program.Rules {
+		if randomizer.Intn(1000) >= int(rate*1000) {
+			continue
+		}
+		switch randomizer.Intn(3) {
+		case 0:
+			program.Rules[i].When = randomCondition(randomizer)
+		case 1:
+			program.Rules[i].Base = randomBase(randomizer)
+		default:
+			weights := &program.Rules[i].Weights
+			weights[randomizer.Intn(len(weights))] = randomizer.Intn(maxWeight + 1)
+			if weights[0]+weights[1]+weights[2] == 0 {
+				weights[randomizer.Intn(len(weights))] = 1
+			}
+		}
+	}
+	if randomizer.Intn(1000) < int(rate*1000) {
+		switch {
+		case len(program.Rules) < maxRules && randomizer.Intn(2) == 0:
+			at := randomizer.Intn(len(program.Rules) + 1)
+			program.Rules = slices.Insert(program.Rules, at, randomRule(randomizer))
+		case len(program.Rules) > 1:
+			at := randomizer.Intn(len(program.Rules))
+			program.Rules = slices.Delete(program.Rules, at, at+1)
+		}
+	}
+}
+
+func randomProgram(randomizer model.Randomizer, maxRules int) *strategies.Program {
+	rules := make([]strategies.Rule, 1+randomizer.Intn(maxRules))
+	for i := range rules {
+		rules[i] = randomRule(randomizer)
+	}
+	return &strategies.Program{Version: strategies.ProgramVersion, Rules: rules}
+}
+
+func randomRule(randomizer model.Randomizer) strategies.Rule {
+	rule := strategies.Rule{When: randomCondition(randomizer), Base: randomBase(randomizer)}
+	for rule.Weights[0]+rule.Weights[1]+rule.Weights[2] == 0 {
+		for i := range rule.Weights {
+			rule.Weights[i] = randomizer.Intn(maxWeight + 1)
+		}
+	}
+	return rule
+}
+
+func randomCondition(randomizer model.Randomizer) strategies.Condition {
+	lag := 1 + randomizer.Intn(strategies.MaxLag)
+	switch randomizer.Intn(4) {
+	case 0:
+		return strategies.Condition{Subject: strategies.SubjectAlways}
+	case 1:
+		return strategies.Condition{Subject: strategies.SubjectOwn, Lag: lag, Value: 1 + randomizer.Intn(3)}
+	case 2:
+		return strategies.Condition{Subject: strategies.SubjectOpponent, Lag: lag, Value: 1 + randomizer.Intn(3)}
+	}
+	return strategies.Condition{Subject: strategies.SubjectOutcome, Lag: lag, Value: 1 + randomizer.Intn(3)}
+}
+
+func randomBase(randomizer model.Randomizer) string {
+	return []string{strategies.BaseAbsolute, strategies.BaseOwn, strategies.BaseOpponent}[randomizer.Intn(3)]
+}
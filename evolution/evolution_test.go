@@ -0,0 +1,74 @@
+package evolution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+func TestEvolve(t *testing.T) {
+	cfg := Config{
+		Opponents:    []string{"rock", "cycler"},
+		Population:   20,
+		Generations:  15,
+		Games:        5,
+		WinningScore: 3,
+		MaxRounds:    50,
+		MaxRules:     4,
+		MutationRate: 0.3,
+		Seed:         1,
+	}
+	generations := 0
+	best, err := Evolve(cfg, func(generation int, best Individual) {
+		generations++
+		assert.Equal(t, generations, generation)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, cfg.Generations, generations)
+	assert.NoError(t, best.Program.Validate())
+	assert.LessOrEqual(t, len(best.Program.Rules), cfg.MaxRules)
+	assert.Greater(t, best.Fitness, 0.5, "predictable opponents are beaten")
+
+	again, err := Evolve(cfg, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, best, again, "the same seed reproduces the evolution")
+}
+
+func TestEvolve_errors(t *testing.T) {
+	valid := Config{Opponents: []string{"rock"}, Population: 5, Generations: 1, Games: 1, WinningScore: 3, MaxRounds: 10, MaxRules: 2}
+	tests := []struct {
+		name   string
+		change func(cfg *Config)
+	}{
+		{"no opponents", func(cfg *Config) { cfg.Opponents = nil }},
+		{"unknown opponent", func(cfg *Config) { cfg.Opponents = []string{"lizard"} }},
+		{"population too small", func(cfg *Config) { cfg.Population = elites }},
+		{"no rules", func(cfg *Config) { cfg.MaxRules = 0 }},
+		{"mutation rate out of range", func(cfg *Config) { cfg.MutationRate = 1.5 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.change(&cfg)
+			_, err := Evolve(cfg, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_mutate(t *testing.T) {
+	randomizer := random.NewPCG(1, 1)
+	for i := 0; i < 200; i++ {
+		program := randomProgram(randomizer, 3)
+		mutate(randomizer, program, 1, 3)
+		child := crossover(randomizer, program, randomProgram(randomizer, 3), 3)
+		for _, p := range []*strategies.Program{program, child} {
+			assert.NoError(t, p.Validate())
+			assert.NotEmpty(t, p.Rules)
+			assert.LessOrEqual(t, len(p.Rules), 3)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Package fairness lets a player prove it chose its move before seeing the other one: it publishes
+// a SHA-256 commitment of the move and a random nonce before the round, and reveals both after it.
+package fairness
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// nonceSize is the number of random bytes of a nonce, enough to make the commitments impossible to
+// match against the three possible moves.
+const nonceSize = 16
+
+// NewNonce returns a hex encoded random nonce from crypto/rand.
+func NewNonce() string {
+	b := make([]byte, nonceSize)
+	// crypto/rand.Read never returns an error, it crashes the program when the system source fails
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Commit returns the hex encoded SHA-256 of "<move>:<nonce>", the move in lower case, e.g. "paper:3f9a...".
+func Commit(move model.Move, nonce string) string {
+	sum := sha256.Sum256([]byte(Preimage(move, nonce)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Preimage returns the text whose SHA-256 is the commitment.
+func Preimage(move model.Move, nonce string) string {
+	return strings.ToLower(model.MoveToStr[move]) + ":" + nonce
+}
+
+// Verify reports whether the commitment matches the revealed move and nonce.
+func Verify(commitment string, move model.Move, nonce string) bool {
+	return move.Valid() && nonce != "" && strings.EqualFold(commitment, Commit(move, nonce))
+}
@@ -0,0 +1,47 @@
+package fairness
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestCommit(t *testing.T) {
+	// echo -n "rock:ab12" | sha256sum
+	assert.Equal(t, "rock:ab12", Preimage(model.Rock, "ab12"))
+	assert.Equal(t, "a22e1958b5c374c41ba16669d33731644c871f196758b5acc08bf7d5a3a59032", Commit(model.Rock, "ab12"))
+	assert.NotEqual(t, Commit(model.Rock, "ab12"), Commit(model.Rock, "ab13"))
+	assert.NotEqual(t, Commit(model.Rock, "ab12"), Commit(model.Paper, "ab12"))
+}
+
+func TestNewNonce(t *testing.T) {
+	nonce := NewNonce()
+	assert.Len(t, nonce, 2*nonceSize)
+	assert.NotEqual(t, nonce, NewNonce())
+}
+
+func TestVerify(t *testing.T) {
+	commitment := Commit(model.Scissors, "ab12")
+	tests := []struct {
+		name       string
+		commitment string
+		move       model.Move
+		nonce      string
+		want       bool
+	}{
+		{name: "matching", commitment: commitment, move: model.Scissors, nonce: "ab12", want: true},
+		{name: "upper case commitment", commitment: strings.ToUpper(commitment), move: model.Scissors, nonce: "ab12", want: true},
+		{name: "changed move", commitment: commitment, move: model.Rock, nonce: "ab12"},
+		{name: "changed nonce", commitment: commitment, move: model.Scissors, nonce: "ab13"},
+		{name: "no nonce", commitment: Commit(model.Scissors, ""), move: model.Scissors},
+		{name: "invalid move", commitment: commitment, move: 0, nonce: "ab12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Verify(tt.commitment, tt.move, tt.nonce))
+		})
+	}
+}
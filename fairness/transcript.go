@@ -0,0 +1,124 @@
+package fairness
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Kinds of transcript entries: the commitment is written before the opponent chooses, and the
+// reveal after the round.
+const (
+	EntryCommit = "commit"
+	EntryReveal = "reveal"
+)
+
+// Entry is a line of a transcript, one JSON object per line.
+type Entry struct {
+	Kind   string `json:"kind"`
+	Round  int    `json:"round"`
+	Player string `json:"player"`
+	// Commitment is only set by commit entries, Move, Nonce and Opponent by reveal entries.
+	Commitment string `json:"commitment,omitempty"`
+	Move       string `json:"move,omitempty"`
+	Nonce      string `json:"nonce,omitempty"`
+	Opponent   string `json:"opponent,omitempty"`
+}
+
+// NewCommitEntry creates the entry of a commitment, written before the opponent chooses.
+func NewCommitEntry(round int, player, commitment string) Entry {
+	return Entry{Kind: EntryCommit, Round: round, Player: player, Commitment: commitment}
+}
+
+// NewRevealEntry creates the entry of a revealed round.
+func NewRevealEntry(round int, player string, move model.Move, nonce string, opponent model.Move) Entry {
+	return Entry{
+		Kind:     EntryReveal,
+		Round:    round,
+		Player:   player,
+		Move:     model.MoveToStr[move],
+		Nonce:    nonce,
+		Opponent: model.MoveToStr[opponent],
+	}
+}
+
+// Check is the verification of a round of a transcript.
+type Check struct {
+	Round      int
+	Player     string
+	Move       string
+	Opponent   string
+	Commitment string
+	// Problem tells why the round fails, empty when it passes.
+	Problem string
+}
+
+// CheckTranscript checks every reveal against the commitment written on an earlier line for the
+// same round and player, so a move cannot have been chosen after the opponent's. A commitment left
+// unrevealed, as when the player exits during a round, is not checked.
+func CheckTranscript(entries []Entry) []Check {
+	type key struct {
+		round  int
+		player string
+	}
+	commitments := map[key]string{}
+	pending := map[key]bool{}
+	var checks []Check
+	for _, entry := range entries {
+		k := key{entry.Round, entry.Player}
+		switch entry.Kind {
+		case EntryCommit:
+			if _, ok := commitments[k]; ok {
+				checks = append(checks, Check{Round: entry.Round, Player: entry.Player,
+					Commitment: entry.Commitment, Problem: "committed twice"})
+				continue
+			}
+			commitments[k] = entry.Commitment
+			pending[k] = true
+		case EntryReveal:
+			check := Check{Round: entry.Round, Player: entry.Player, Move: entry.Move, Opponent: entry.Opponent}
+			commitment, ok := commitments[k]
+			move, err := model.ParseMove(entry.Move)
+			switch {
+			case !ok:
+				check.Problem = "no earlier commitment"
+			case !pending[k]:
+				check.Problem = "revealed twice"
+			case err != nil || !Verify(commitment, move, entry.Nonce):
+				check.Problem = "the move does not match the commitment"
+			}
+			check.Commitment = commitment
+			delete(pending, k)
+			checks = append(checks, check)
+		default:
+			checks = append(checks, Check{Round: entry.Round, Player: entry.Player,
+				Problem: fmt.Sprintf("unknown entry kind %q", entry.Kind)})
+		}
+	}
+	return checks
+}
+
+// WriteEntry appends the entry to a transcript.
+func WriteEntry(w io.Writer, entry Entry) error {
+	return json.NewEncoder(w).Encode(entry)
+}
+
+// ReadTranscript reads the entries of a transcript.
+func ReadTranscript(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		entry := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
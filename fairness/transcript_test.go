@@ -0,0 +1,65 @@
+package fairness
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestTranscript(t *testing.T) {
+	entries := []Entry{
+		NewCommitEntry(1, "ROBOT", Commit(model.Rock, "ab12")),
+		NewRevealEntry(1, "ROBOT", model.Rock, "ab12", model.Paper),
+	}
+	buf := &bytes.Buffer{}
+	for _, entry := range entries {
+		assert.NoError(t, WriteEntry(buf, entry))
+	}
+	assert.Equal(t, `{"kind":"commit","round":1,"player":"ROBOT","commitment":"`+Commit(model.Rock, "ab12")+`"}`+"\n"+
+		`{"kind":"reveal","round":1,"player":"ROBOT","move":"Rock","nonce":"ab12","opponent":"Paper"}`+"\n", buf.String())
+
+	read, err := ReadTranscript(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, entries, read)
+}
+
+func TestCheckTranscript(t *testing.T) {
+	entries := []Entry{
+		NewCommitEntry(1, "ROBOT", Commit(model.Rock, "ab12")),
+		NewRevealEntry(1, "ROBOT", model.Rock, "ab12", model.Paper),
+		NewCommitEntry(2, "ROBOT", Commit(model.Rock, "cd34")),
+		NewRevealEntry(2, "ROBOT", model.Scissors, "cd34", model.Paper),
+		// the commitment comes after the reveal, so it proves nothing
+		NewRevealEntry(3, "ROBOT", model.Paper, "ef56", model.Rock),
+		NewCommitEntry(3, "ROBOT", Commit(model.Paper, "ef56")),
+		NewCommitEntry(3, "ROBOT", Commit(model.Paper, "ef56")),
+		NewRevealEntry(1, "ROBOT", model.Rock, "ab12", model.Paper),
+		// the last round was left when the player exited
+		NewCommitEntry(4, "ROBOT", Commit(model.Rock, "0a0b")),
+		{Kind: "note", Round: 5, Player: "ROBOT"},
+	}
+
+	var problems []string
+	for _, check := range CheckTranscript(entries) {
+		problems = append(problems, check.Problem)
+	}
+	assert.Equal(t, []string{
+		"",
+		"the move does not match the commitment",
+		"no earlier commitment",
+		"committed twice",
+		"revealed twice",
+		`unknown entry kind "note"`,
+	}, problems)
+	assert.Equal(t, Check{Round: 1, Player: "ROBOT", Move: "Rock", Opponent: "Paper", Commitment: Commit(model.Rock, "ab12")},
+		CheckTranscript(entries)[0])
+}
+
+func TestReadTranscript_invalid(t *testing.T) {
+	_, err := ReadTranscript(strings.NewReader("{\"round\":1}\n\nnot json\n"))
+	assert.EqualError(t, err, "line 3: invalid character 'o' in literal null (expecting 'u')")
+}
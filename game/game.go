@@ -2,11 +2,14 @@ package game
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/history"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
 )
 
 // Game represents the core game state and dependencies.
@@ -14,6 +17,9 @@ type Game struct {
 	throw    *Throw
 	cliInput model.InputWatcher
 	roundFn  roundFunc
+	// store receives the finished matches, seed is the seed of the randomness recorded with them.
+	store model.MatchStore
+	seed  int64
 }
 
 func InitGame(cliInput model.InputWatcher, throw *Throw) *Game {
@@ -24,6 +30,16 @@ func InitGame(cliInput model.InputWatcher, throw *Throw) *Game {
 	}
 }
 
+// SetStore makes the game append every finished match to the store.
+func (r *Game) SetStore(store model.MatchStore) {
+	r.store = store
+}
+
+// SetSeed sets the seed recorded with the matches, so they can be replayed.
+func (r *Game) SetSeed(seed int64) {
+	r.seed = seed
+}
+
 // Play executes the game loop between two players until a game winner is defined,
 // then recursively restarts if players doesn't exit.
 func (r *Game) Play(ctx context.Context, p1, p2 model.Player) {
@@ -42,13 +58,30 @@ func (r *Game) Play(ctx context.Context, p1, p2 model.Player) {
 	cli.MoveCursorUpLeft()
 	time.Sleep(model.Span.Time1s)
 
+	match := model.Match{
+		Ruleset:      model.RulesetClassic,
+		Seed:         r.seed,
+		WinningScore: winningScore,
+		Players:      [2]string{p1.GetName(), p2.GetName()},
+		Strategies:   [2]string{strategyName(p1), strategyName(p2)},
+		StartedAt:    time.Now(),
+	}
+
 	// round loop continues until a player wins the game or chooses to exit.
-	for p1.GetScore() < winningScore && p2.GetScore() < winningScore {
+	for round := 1; p1.GetScore() < winningScore && p2.GetScore() < winningScore; round++ {
 		cli.DisplayRoundScore(p1, p2, winningScore)
+		if round == 1 {
+			// below the score table, which clears the screen, so it stays until the first move
+			r.displayRivalry(match)
+		}
+		score1, score2 := p1.GetScore(), p2.GetScore()
 		r.roundFn(ctx, p1, p2, r.throw)
 		if ctx.Err() != nil {
 			return
 		}
+		if r.store != nil {
+			match.Rounds = append(match.Rounds, playedRound(p1, p2, score1, score2))
+		}
 	}
 	if ctx.Err() != nil {
 		return
@@ -56,10 +89,13 @@ func (r *Game) Play(ctx context.Context, p1, p2 model.Player) {
 
 	// display the game winner
 	if p1.GetScore() == winningScore {
-		cli.CongratulationsWinner(p1.GetName())
+		match.Winner = p1.GetName()
 	} else {
-		cli.CongratulationsWinner(p2.GetName())
+		match.Winner = p2.GetName()
 	}
+	cli.CongratulationsWinner(match.Winner)
+	r.saveMatch(match)
+	r.displayRivalry(match)
 
 	// Ignores anything that is not exit, then restarts the game if context is not cancelled.
 	_, _ = r.cliInput.Number(
@@ -75,3 +111,55 @@ func (r *Game) Play(ctx context.Context, p1, p2 model.Player) {
 	cli.MoveCursorUpLeft()
 	r.Play(ctx, p1, p2)
 }
+
+// playedRound records the moves of the last round, whose winner is the player whose score increased.
+func playedRound(p1, p2 model.Player, score1, score2 int) model.MatchRound {
+	played := model.MatchRound{Moves: [2]model.Move{p1.GetMove(), p2.GetMove()}, At: time.Now()}
+	switch {
+	case p1.GetScore() > score1:
+		played.Winner = p1.GetName()
+	case p2.GetScore() > score2:
+		played.Winner = p2.GetName()
+	}
+	return played
+}
+
+// strategyName returns the strategy of an automated player, or an empty string for a human.
+func strategyName(p model.Player) string {
+	if player, ok := p.(model.StrategyPlayer); ok {
+		return player.StrategyName()
+	}
+	return ""
+}
+
+// saveMatch appends the finished match to the store, if any.
+func (r *Game) saveMatch(match model.Match) {
+	if r.store == nil {
+		return
+	}
+	match.EndedAt = time.Now()
+	switch err := r.store.Append(match); {
+	case errors.Is(err, rating.ErrRatings):
+		fmt.Printf("the match was saved, but %v\n", err)
+	case err != nil:
+		fmt.Printf("cannot save the match history: %v\n", err)
+	}
+}
+
+// displayRivalry prints the head-to-head record of the players of the match, if they have met before
+// (before the match) or met again (after it).
+func (r *Game) displayRivalry(match model.Match) {
+	if r.store == nil {
+		return
+	}
+	matches, err := r.store.Matches()
+	if err != nil {
+		return
+	}
+	sides := history.Sides(match)
+	rivalry := history.FindRivalry(matches, sides[0], sides[1])
+	if rivalry.Games() == 0 || (match.Winner != "" && rivalry.Games() < 2) {
+		return
+	}
+	cli.DisplayRivalry(rivalry)
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yuripiffer/rock-paper-scissors/history"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
 	"github.com/yuripiffer/rock-paper-scissors/testutils"
 )
 
@@ -151,3 +155,229 @@ func TestGame_Play(t *testing.T) {
 		})
 	}
 }
+
+func TestGame_Play_history(t *testing.T) {
+	restoreStdout, err := testutils.SilenceStdout()
+	assert.NoError(t, err)
+	defer restoreStdout()
+	restoreTimeSpan := testutils.IgnoreSleep()
+	defer restoreTimeSpan()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// a draw, then ANA wins the game of one point
+	moves := [][2]model.Move{{model.Rock, model.Rock}, {model.Paper, model.Rock}}
+	winners := []int{0, 1}
+	var played [2]model.Move
+	var score [2]int
+	p1 := &model.PlayerMock{
+		GetNameFunc:  func() string { return "ANA" },
+		GetScoreFunc: func() int { return score[0] },
+		GetMoveFunc:  func() model.Move { return played[0] },
+	}
+	p2 := &model.PlayerMock{
+		GetNameFunc:  func() string { return "ROBOT" },
+		GetScoreFunc: func() int { return score[1] },
+		GetMoveFunc:  func() model.Move { return played[1] },
+	}
+	inputs := []int{1, 0}
+	inputMock := &model.InputWatcherMock{
+		NumberFunc: func(message string) (int, error) {
+			n := inputs[0]
+			inputs = inputs[1:]
+			if len(inputs) == 0 {
+				// the player exits after the game
+				cancel()
+			}
+			return n, nil
+		},
+	}
+	store := history.NewMemory()
+	game := &Game{
+		throw:    &Throw{},
+		cliInput: inputMock,
+		roundFn: func(ctx context.Context, p1, p2 model.Player, throw *Throw) {
+			played, moves = moves[0], moves[1:]
+			score[0] += winners[0]
+			winners = winners[1:]
+		},
+	}
+	game.SetStore(store)
+	game.SetSeed(42)
+
+	game.Play(ctx, p1, p2)
+
+	matches, err := store.Matches()
+	assert.NoError(t, err)
+	if assert.Len(t, matches, 1) {
+		match := matches[0]
+		assert.Equal(t, model.RulesetClassic, match.Ruleset)
+		assert.Equal(t, int64(42), match.Seed)
+		assert.Equal(t, 1, match.WinningScore)
+		assert.Equal(t, [2]string{"ANA", "ROBOT"}, match.Players)
+		assert.Equal(t, "ANA", match.Winner)
+		assert.False(t, match.EndedAt.Before(match.StartedAt))
+		if assert.Len(t, match.Rounds, 2) {
+			assert.Equal(t, [2]model.Move{model.Rock, model.Rock}, match.Rounds[0].Moves)
+			assert.Equal(t, "", match.Rounds[0].Winner, "a draw")
+			assert.Equal(t, [2]model.Move{model.Paper, model.Rock}, match.Rounds[1].Moves)
+			assert.Equal(t, "ANA", match.Rounds[1].Winner)
+		}
+	}
+}
+
+func TestGame_Play_forfeit(t *testing.T) {
+	restoreStdout, err := testutils.SilenceStdout()
+	assert.NoError(t, err)
+	defer restoreStdout()
+	restoreTimeSpan := testutils.IgnoreSleep()
+	defer restoreTimeSpan()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the bot forfeits the only round, so it has no move
+	var played [2]model.Move
+	var score [2]int
+	p1 := &model.PlayerMock{
+		GetNameFunc:  func() string { return "ANA" },
+		GetScoreFunc: func() int { return score[0] },
+		GetMoveFunc:  func() model.Move { return played[0] },
+	}
+	p2 := &model.PlayerMock{
+		GetNameFunc:  func() string { return "BOT" },
+		GetScoreFunc: func() int { return score[1] },
+		GetMoveFunc:  func() model.Move { return played[1] },
+	}
+	inputs := []int{1, 0}
+	inputMock := &model.InputWatcherMock{
+		NumberFunc: func(message string) (int, error) {
+			n := inputs[0]
+			inputs = inputs[1:]
+			if len(inputs) == 0 {
+				cancel()
+			}
+			return n, nil
+		},
+	}
+	store := history.NewFile(filepath.Join(t.TempDir(), "history.jsonl"))
+	game := &Game{
+		throw:    &Throw{},
+		cliInput: inputMock,
+		roundFn: func(ctx context.Context, p1, p2 model.Player, throw *Throw) {
+			played = [2]model.Move{model.Paper, 0}
+			score[0]++
+		},
+	}
+	game.SetStore(store)
+
+	game.Play(ctx, p1, p2)
+
+	matches, err := store.Matches()
+	assert.NoError(t, err)
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, "ANA", matches[0].Winner)
+		if assert.Len(t, matches[0].Rounds, 1) {
+			assert.Equal(t, [2]model.Move{model.Paper, 0}, matches[0].Rounds[0].Moves)
+			assert.Equal(t, "ANA", matches[0].Rounds[0].Winner)
+		}
+	}
+}
+
+func TestGame_Play_rivalry(t *testing.T) {
+	restoreTimeSpan := testutils.IgnoreSleep()
+	defer restoreTimeSpan()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var score [2]int
+	p1 := &model.PlayerMock{
+		GetNameFunc:  func() string { return "ANA" },
+		GetScoreFunc: func() int { return score[0] },
+		GetMoveFunc:  func() model.Move { return model.Paper },
+	}
+	p2 := &model.PlayerMock{
+		GetNameFunc:  func() string { return "PAUL" },
+		GetScoreFunc: func() int { return score[1] },
+		GetMoveFunc:  func() model.Move { return model.Rock },
+	}
+	inputs := []int{1, 0}
+	inputMock := &model.InputWatcherMock{
+		NumberFunc: func(message string) (int, error) {
+			n := inputs[0]
+			inputs = inputs[1:]
+			if len(inputs) == 0 {
+				cancel()
+			}
+			return n, nil
+		},
+	}
+	store := history.NewMemory()
+	assert.NoError(t, store.Append(model.Match{Players: [2]string{"PAUL", "ANA"}, Winner: "PAUL"}))
+	game := &Game{
+		throw:    &Throw{},
+		cliInput: inputMock,
+		roundFn: func(ctx context.Context, p1, p2 model.Player, throw *Throw) {
+			score[0]++
+		},
+	}
+	game.SetStore(store)
+
+	out, err := testutils.CaptureStdout(func() {
+		game.Play(ctx, p1, p2)
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, "Head-to-head: ANA 0 - 1 PAUL in 1 game")
+	assert.Contains(t, out, "Head-to-head: ANA 1 - 1 PAUL in 2 games")
+	assert.Less(t, strings.Index(out, "in 1 game\n"), strings.Index(out, "WINNER"))
+	// the screen is not cleared between the first score table and the banner
+	banner := strings.Index(out, "=== RIVALRY")
+	lastClear := strings.LastIndex(out[:banner], "\033[2J\033[H")
+	assert.Contains(t, out[lastClear:banner], "winning score = 1")
+	assert.Greater(t, strings.Index(out, "in 2 games"), strings.Index(out, "WINNER"))
+}
+
+// failingStore is a match store whose appends fail with err.
+type failingStore struct {
+	history.Memory
+	err error
+}
+
+func (r *failingStore) Append(match model.Match) error {
+	return r.err
+}
+
+func TestGame_saveMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantOut string
+	}{
+		{
+			name:    "saved",
+			wantOut: "",
+		},
+		{
+			name:    "history not saved",
+			err:     errors.New("disk full"),
+			wantOut: "cannot save the match history: disk full\n",
+		},
+		{
+			name:    "ratings not updated",
+			err:     fmt.Errorf("%w: %v", rating.ErrRatings, errors.New("bad ratings.json")),
+			wantOut: "the match was saved, but cannot update the ratings: bad ratings.json\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := &Game{store: &failingStore{err: tt.err}}
+			out, err := testutils.CaptureStdout(func() {
+				game.saveMatch(model.Match{Players: [2]string{"ANA", "ROBOT"}})
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, out)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Playback controls, typed as lines while a match is replayed.
+const (
+	ControlStep   = "n"
+	ControlBack   = "b"
+	ControlPause  = "p"
+	ControlFaster = "+"
+	ControlSlower = "-"
+	ControlQuit   = "q"
+)
+
+// Replay plays a recorded match again through the display of the game, at a playback speed
+// changed by the controls it reads.
+type Replay struct {
+	controls <-chan string
+	throw    *Throw
+	roundFn  roundFunc
+	speed    float64
+	paused   bool
+	// span holds the time spans at normal speed.
+	span model.TimeSpan
+}
+
+func InitReplay(controls <-chan string, speed float64) *Replay {
+	return &Replay{
+		controls: controls,
+		throw:    &Throw{},
+		roundFn:  round,
+		speed:    speed,
+	}
+}
+
+// Pause makes the replay start paused, so it plays one round at every step.
+func (r *Replay) Pause() {
+	r.paused = true
+}
+
+// Play replays the rounds of the match with the players, which play the recorded moves, until the
+// end of the match or until the viewer quits.
+func (r *Replay) Play(ctx context.Context, match model.Match, p1, p2 model.Player) {
+	r.span = model.Span
+	defer func() { model.Span = r.span }()
+	r.setSpeed(r.speed)
+
+	played := 0
+	for ctx.Err() == nil {
+		cli.DisplayRoundScore(p1, p2, match.WinningScore)
+		fmt.Printf("Replay of round %d/%d at speed x%g", played, len(match.Rounds), r.speed)
+		if !r.paused {
+			fmt.Printf(", type %s and Enter to pause", ControlPause)
+		}
+		fmt.Println()
+		ended := played == len(match.Rounds)
+		if ended {
+			cli.CongratulationsWinner(match.Winner)
+		}
+
+		switch r.nextControl(ctx) {
+		case ControlQuit:
+			return
+		case ControlPause:
+			r.paused = !r.paused
+			continue
+		case ControlFaster:
+			r.setSpeed(r.speed * 2)
+			continue
+		case ControlSlower:
+			r.setSpeed(r.speed / 2)
+			continue
+		case ControlBack:
+			played = max(played-1, 0)
+			for _, p := range []model.Player{p1, p2} {
+				if seeker, ok := p.(model.Seeker); ok {
+					seeker.Seek(played)
+				}
+			}
+			r.throw.reset()
+			continue
+		}
+
+		if ended {
+			return
+		}
+		r.roundFn(ctx, p1, p2, r.throw)
+		played++
+	}
+}
+
+// nextControl waits for a control while the replay is paused, a step being the default, and
+// otherwise takes one only if it was already typed.
+func (r *Replay) nextControl(ctx context.Context) string {
+	if !r.paused {
+		select {
+		case control, ok := <-r.controls:
+			if !ok {
+				// the input is over, the replay goes on without controls
+				r.controls = nil
+				return ControlStep
+			}
+			return control
+		default:
+			return ControlStep
+		}
+	}
+	if r.controls == nil {
+		return ControlQuit
+	}
+
+	fmt.Printf("Paused: Enter or %s for the next round, %s to go back, %s to resume, %s or %s to change the speed, %s to quit: ",
+		ControlStep, ControlBack, ControlPause, ControlFaster, ControlSlower, ControlQuit)
+	select {
+	case <-ctx.Done():
+		return ControlQuit
+	case control, ok := <-r.controls:
+		if !ok {
+			return ControlQuit
+		}
+		if control == "" {
+			return ControlStep
+		}
+		return control
+	}
+}
+
+// setSpeed scales the pauses of the display, twice as fast taking half the time.
+func (r *Replay) setSpeed(speed float64) {
+	r.speed = speed
+	scale := func(d time.Duration) time.Duration {
+		return time.Duration(float64(d) / speed)
+	}
+	model.Span = model.TimeSpan{
+		Time100ms: scale(r.span.Time100ms),
+		Time500ms: scale(r.span.Time500ms),
+		Time1s:    scale(r.span.Time1s),
+		Time2s:    scale(r.span.Time2s),
+		Time3s:    scale(r.span.Time3s),
+	}
+}
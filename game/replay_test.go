@@ -0,0 +1,96 @@
+package game
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+// seekerMock is a player mock that records the rounds it seeks.
+type seekerMock struct {
+	*model.PlayerMock
+	seeks []int
+}
+
+func (r *seekerMock) Seek(round int) {
+	r.seeks = append(r.seeks, round)
+}
+
+func TestReplay_Play(t *testing.T) {
+	restoreTimeSpan := testutils.IgnoreSleep()
+	defer restoreTimeSpan()
+
+	match := model.Match{Players: [2]string{"ANA", "ROBOT"}, Winner: "ANA", WinningScore: 2, Rounds: make([]model.MatchRound, 2)}
+	tests := []struct {
+		name       string
+		paused     bool
+		controls   []string
+		closed     bool
+		wantRounds int
+		wantSeeks  []int
+		wantOuts   []string
+	}{
+		{
+			name:       "plays to the end",
+			wantRounds: 2,
+			wantOuts:   []string{"Replay of round 2/2 at speed x1, type p and Enter to pause", "ANA\x1b[0m is the WINNER"},
+		},
+		{
+			name:       "steps back and forth",
+			paused:     true,
+			controls:   []string{"n", "b", "+", "", "p"},
+			wantRounds: 3,
+			wantSeeks:  []int{0},
+			wantOuts:   []string{"Paused: Enter or n for the next round", "Replay of round 1/2 at speed x2\n", "Replay of round 2/2 at speed x2, type p"},
+		},
+		{
+			name:     "quits",
+			controls: []string{"q"},
+		},
+		{
+			name:       "the input is over while paused",
+			paused:     true,
+			controls:   []string{"n", "-"},
+			closed:     true,
+			wantRounds: 1,
+			wantOuts:   []string{"speed x0.5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controls := make(chan string, len(tt.controls))
+			for _, control := range tt.controls {
+				controls <- control
+			}
+			if tt.closed {
+				close(controls)
+			}
+			p1 := &seekerMock{PlayerMock: &model.PlayerMock{GetNameFunc: func() string { return "ANA" }}}
+			p2 := &seekerMock{PlayerMock: &model.PlayerMock{GetNameFunc: func() string { return "ROBOT" }}}
+			rounds := 0
+			replay := InitReplay(controls, 1)
+			replay.roundFn = func(ctx context.Context, p1, p2 model.Player, throw *Throw) {
+				rounds++
+			}
+			if tt.paused {
+				replay.Pause()
+			}
+
+			out, err := testutils.CaptureStdout(func() {
+				replay.Play(context.Background(), match, p1, p2)
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRounds, rounds)
+			assert.Equal(t, tt.wantSeeks, p1.seeks)
+			assert.Equal(t, tt.wantSeeks, p2.seeks)
+			for _, want := range tt.wantOuts {
+				assert.Contains(t, out, want)
+			}
+			assert.Equal(t, model.TimeSpan{}, model.Span, "the speed is restored")
+		})
+	}
+}
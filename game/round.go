@@ -3,6 +3,7 @@ package game
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/yuripiffer/rock-paper-scissors/cli"
@@ -26,8 +27,7 @@ type roundFunc func(ctx context.Context, p1, p2 model.Player, throw *Throw)
 
 // round executes a rock paper & scissors throw
 func round(ctx context.Context, p1, p2 model.Player, throw *Throw) {
-	p1.SetNextMove()
-	p2.SetNextMove()
+	shown := chooseMoves(p1, p2)
 	if ctx.Err() != nil {
 		return
 	}
@@ -56,21 +56,49 @@ func round(ctx context.Context, p1, p2 model.Player, throw *Throw) {
 		fmt.Println("It's a draw!")
 		throw.reset()
 	}
+	cli.DisplayReveals([]model.Player{p1, p2}, shown)
+	notifyObservers(p1, p2)
+	cli.DisplayExplanations([]model.Player{p1, p2})
 	time.Sleep(model.Span.Time3s)
 }
 
+// chooseMoves lets the players that commit to their move choose first and publishes their commitments
+// before the other players choose, so the committed moves provably do not depend on the others. It
+// returns the commitments shown, by player name.
+func chooseMoves(p1, p2 model.Player) map[string]string {
+	first, second := p1, p2
+	if _, ok := p2.(model.Committer); ok {
+		if _, ok = p1.(model.Committer); !ok {
+			first, second = p2, p1
+		}
+	}
+	first.SetNextMove()
+	shown := cli.DisplayCommitments([]model.Player{first})
+	second.SetNextMove()
+	maps.Copy(shown, cli.DisplayCommitments([]model.Player{second}))
+	return shown
+}
+
 // winnerIs determines winner of the round and returns its name.
 func winnerIs(p1, p2 model.Player) string {
-	// tie
-	if p1.GetMove() == p2.GetMove() {
-		return ""
-	} else
+	switch {
 	// player 1 wins the round.
-	if p1.GetMove() == model.Paper && p2.GetMove() == model.Rock ||
-		p1.GetMove() == model.Rock && p2.GetMove() == model.Scissors ||
-		p1.GetMove() == model.Scissors && p2.GetMove() == model.Paper {
+	case model.Beats(p1.GetMove(), p2.GetMove()):
 		return p1.GetName()
-	}
 	// player 2 wins the round.
-	return p2.GetName()
+	case model.Beats(p2.GetMove(), p1.GetMove()):
+		return p2.GetName()
+	}
+	// tie
+	return ""
+}
+
+// notifyObservers tells the players that observe rounds which moves were played.
+func notifyObservers(p1, p2 model.Player) {
+	if o, ok := p1.(model.RoundObserver); ok {
+		o.ObserveRound(p1.GetMove(), p2.GetMove())
+	}
+	if o, ok := p2.(model.RoundObserver); ok {
+		o.ObserveRound(p2.GetMove(), p1.GetMove())
+	}
 }
@@ -202,6 +202,23 @@ func TestGame_round(t *testing.T) {
 				WinnerName: p2Name,
 			},
 		},
+		{
+			name:   "player 2 forfeited and plays no move, player 1 wins",
+			p1Name: p1Name,
+			p2Name: p2Name,
+			p1Move: model.Rock,
+			p2Move: 0,
+			startThrow: &Throw{
+				WinnerMove: 0,
+				LoserMove:  0,
+				WinnerName: "",
+			},
+			finishThrow: &Throw{
+				WinnerMove: model.Rock,
+				LoserMove:  0,
+				WinnerName: p1Name,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -232,3 +249,65 @@ func TestGame_round(t *testing.T) {
 		})
 	}
 }
+
+// observerMock is a Player that records the rounds it observes.
+type observerMock struct {
+	*model.PlayerMock
+	observed [][2]model.Move
+}
+
+func (r *observerMock) ObserveRound(own, opponent model.Move) {
+	r.observed = append(r.observed, [2]model.Move{own, opponent})
+}
+
+func TestGame_notifyObservers(t *testing.T) {
+	p1 := &observerMock{PlayerMock: &model.PlayerMock{
+		GetMoveFunc: func() model.Move { return model.Rock },
+	}}
+	p2 := &model.PlayerMock{
+		GetMoveFunc: func() model.Move { return model.Paper },
+	}
+	p3 := &observerMock{PlayerMock: &model.PlayerMock{
+		GetMoveFunc: func() model.Move { return model.Scissors },
+	}}
+
+	notifyObservers(p1, p2)
+	notifyObservers(p2, p3)
+
+	assert.Equal(t, [][2]model.Move{{model.Rock, model.Paper}}, p1.observed)
+	assert.Equal(t, [][2]model.Move{{model.Scissors, model.Paper}}, p3.observed)
+}
+
+// committerMock is a Player that commits to its move before the other player chooses.
+type committerMock struct {
+	*model.PlayerMock
+}
+
+func (r *committerMock) Commitment() string {
+	return "c0ffee"
+}
+
+func (r *committerMock) Reveal() (model.Move, string) {
+	return model.Rock, "ab12"
+}
+
+func TestGame_chooseMoves(t *testing.T) {
+	var order []string
+	human := &model.PlayerMock{
+		GetNameFunc:     func() string { return "ANA" },
+		SetNextMoveFunc: func() { order = append(order, "ANA") },
+	}
+	computer := &committerMock{PlayerMock: &model.PlayerMock{
+		GetNameFunc:     func() string { return "ROBOT" },
+		SetNextMoveFunc: func() { order = append(order, "ROBOT") },
+	}}
+
+	var shown map[string]string
+	out, err := testutils.CaptureStdout(func() {
+		shown = chooseMoves(human, computer)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ROBOT", "ANA"}, order, "the committer chooses first")
+	assert.Equal(t, map[string]string{"ROBOT": "c0ffee"}, shown)
+	assert.Equal(t, "ROBOT has chosen its move, commitment c0ffee\n", out)
+}
@@ -0,0 +1,110 @@
+// Package history keeps the finished matches in a JSON Lines file of the data directory, one match per line.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// SchemaVersion is the version of the match records. Records of another version are rejected
+// instead of being misread.
+const SchemaVersion = 1
+
+// FileName is the history file, inside the data directory.
+const FileName = "history.jsonl"
+
+// maxRecordSize bounds a match record, far above what thousands of rounds take.
+const maxRecordSize = 16 << 20
+
+// File is a match store appending to a JSON Lines file. Writers and readers lock the file, so several
+// games can share it.
+type File struct {
+	path string
+}
+
+// NewFile creates the store of the history file at path.
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+// Open creates the store of the history file of the data directory.
+func Open() (*File, error) {
+	path, err := storage.Path(FileName)
+	if err != nil {
+		return nil, err
+	}
+	return NewFile(path), nil
+}
+
+// Path returns the path of the history file.
+func (r *File) Path() string {
+	return r.path
+}
+
+// Append writes the match as a new line of the file.
+func (r *File) Append(match model.Match) error {
+	match.Version = SchemaVersion
+	data, err := json.Marshal(match)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	if err = storage.LockFile(f, true); err != nil {
+		return err
+	}
+	defer func() { _ = storage.UnlockFile(f) }()
+	// a single write of the whole line, so readers never see half a record
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// Matches reads the matches of the file, oldest first. A missing file is an empty history.
+func (r *File) Matches() ([]model.Match, error) {
+	f, err := os.Open(r.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	if err = storage.LockFile(f, false); err != nil {
+		return nil, err
+	}
+	defer func() { _ = storage.UnlockFile(f) }()
+
+	var matches []model.Match
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		match := model.Match{}
+		if err = json.Unmarshal(scanner.Bytes(), &match); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", r.path, line, err)
+		}
+		if match.Version != SchemaVersion {
+			return nil, fmt.Errorf("%s:%d: unsupported record version %d, want %d", r.path, line, match.Version, SchemaVersion)
+		}
+		matches = append(matches, match)
+	}
+	return matches, scanner.Err()
+}
@@ -0,0 +1,112 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func testMatch(winner string) model.Match {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	return model.Match{
+		Ruleset:      model.RulesetClassic,
+		Seed:         42,
+		WinningScore: 1,
+		Players:      [2]string{"ANA", "ROBOT"},
+		Winner:       winner,
+		StartedAt:    start,
+		EndedAt:      start.Add(time.Minute),
+		Rounds: []model.MatchRound{
+			{Moves: [2]model.Move{model.Rock, model.Rock}, At: start.Add(20 * time.Second)},
+			{Moves: [2]model.Move{model.Paper, model.Rock}, Winner: winner, At: start.Add(40 * time.Second)},
+		},
+	}
+}
+
+func TestFile(t *testing.T) {
+	store := NewFile(filepath.Join(t.TempDir(), "nested", FileName))
+
+	matches, err := store.Matches()
+	assert.NoError(t, err)
+	assert.Empty(t, matches, "a missing file is an empty history")
+
+	assert.NoError(t, store.Append(testMatch("ANA")))
+	assert.NoError(t, store.Append(testMatch("ROBOT")))
+
+	matches, err = store.Matches()
+	assert.NoError(t, err)
+	want := []model.Match{testMatch("ANA"), testMatch("ROBOT")}
+	for i := range want {
+		want[i].Version = SchemaVersion
+	}
+	assert.Equal(t, want, matches)
+
+	data, err := os.ReadFile(store.Path())
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"moves":["paper","rock"]`)
+}
+
+func TestFile_Matches_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "newer schema",
+			content: `{"version":2}` + "\n",
+			wantErr: "history.jsonl:1: unsupported record version 2, want 1",
+		},
+		{
+			name:    "corrupted line",
+			content: `{"version":1}` + "\n\n" + `{"version":` + "\n",
+			wantErr: "history.jsonl:3: unexpected end of JSON input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), FileName)
+			assert.NoError(t, os.WriteFile(path, []byte(tt.content), 0o644))
+			_, err := NewFile(path).Matches()
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestFile_Append_concurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// every writer opens its own store, as separate games would
+			assert.NoError(t, NewFile(path).Append(testMatch("ANA")))
+		}()
+	}
+	wg.Wait()
+
+	matches, err := NewFile(path).Matches()
+	assert.NoError(t, err)
+	assert.Len(t, matches, 20)
+}
+
+func TestMemory(t *testing.T) {
+	store := NewMemory()
+	assert.NoError(t, store.Append(testMatch("ANA")))
+
+	matches, err := store.Matches()
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, SchemaVersion, matches[0].Version)
+
+	matches[0].Winner = "changed"
+	stored, _ := store.Matches()
+	assert.Equal(t, "ANA", stored[0].Winner, "the stored matches are copied")
+}
@@ -0,0 +1,34 @@
+package history
+
+import (
+	"sync"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Memory is a match store kept in memory, used by tests.
+type Memory struct {
+	mu      sync.Mutex
+	matches []model.Match
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Append adds the match to the store.
+func (r *Memory) Append(match model.Match) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	match.Version = SchemaVersion
+	r.matches = append(r.matches, match)
+	return nil
+}
+
+// Matches returns a copy of the stored matches, oldest first.
+func (r *Memory) Matches() ([]model.Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]model.Match(nil), r.matches...), nil
+}
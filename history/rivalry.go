@@ -0,0 +1,102 @@
+package history
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Side identifies a player of a match: a human by name, an automated player by name and strategy.
+type Side struct {
+	Name     string
+	Strategy string
+}
+
+// String returns the name, followed by the strategy when the name does not already tell it.
+func (r Side) String() string {
+	if r.Strategy == "" || strings.EqualFold(r.Name, r.Strategy) {
+		return r.Name
+	}
+	return r.Name + " (" + r.Strategy + ")"
+}
+
+// Sides returns the sides of the match.
+func Sides(match model.Match) [2]Side {
+	return [2]Side{
+		{Name: match.Players[0], Strategy: match.Strategies[0]},
+		{Name: match.Players[1], Strategy: match.Strategies[1]},
+	}
+}
+
+// Rivalry is the lifetime head-to-head record of two sides.
+type Rivalry struct {
+	Sides [2]Side
+	// Wins counts the games won by each side.
+	Wins [2]int
+	// Streak is the number of games in a row StreakSide won last.
+	Streak     int
+	StreakSide int
+	// Comeback is the biggest deficit of rounds a side came back from to win a game.
+	Comeback Comeback
+}
+
+// Comeback is a game won from behind.
+type Comeback struct {
+	Side int
+	// Deficit is the largest lead in rounds the other side had, and Score the score at that time,
+	// in the order of the rivalry sides.
+	Deficit int
+	Score   [2]int
+	At      time.Time
+}
+
+// Games returns the number of games of the rivalry.
+func (r Rivalry) Games() int {
+	return r.Wins[0] + r.Wins[1]
+}
+
+// FindRivalry replays the matches between the two sides, oldest first, whichever side played first.
+func FindRivalry(matches []model.Match, a, b Side) Rivalry {
+	rivalry := Rivalry{Sides: [2]Side{a, b}}
+	for _, match := range matches {
+		sides := Sides(match)
+		// order maps the sides of the rivalry to the sides of the match
+		var order [2]int
+		switch sides {
+		case [2]Side{a, b}:
+			order = [2]int{0, 1}
+		case [2]Side{b, a}:
+			order = [2]int{1, 0}
+		default:
+			continue
+		}
+		winner := 0
+		if match.Winner == b.Name {
+			winner = 1
+		}
+		rivalry.Wins[winner]++
+		if rivalry.Streak > 0 && rivalry.StreakSide == winner {
+			rivalry.Streak++
+		} else {
+			rivalry.Streak, rivalry.StreakSide = 1, winner
+		}
+
+		score, worst := [2]int{}, [2]int{}
+		for _, round := range match.Rounds {
+			switch round.Winner {
+			case match.Players[order[0]]:
+				score[0]++
+			case match.Players[order[1]]:
+				score[1]++
+			}
+			if score[1-winner]-score[winner] > worst[1-winner]-worst[winner] {
+				worst = score
+			}
+		}
+		if deficit := worst[1-winner] - worst[winner]; deficit > rivalry.Comeback.Deficit {
+			rivalry.Comeback = Comeback{Side: winner, Deficit: deficit, Score: worst, At: match.EndedAt}
+		}
+	}
+	return rivalry
+}
@@ -0,0 +1,54 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestSide_String(t *testing.T) {
+	assert.Equal(t, "ANA", Side{Name: "ANA"}.String())
+	assert.Equal(t, "CYCLER", Side{Name: "CYCLER", Strategy: "cycler"}.String())
+	assert.Equal(t, "ROBOT (heuristic)", Side{Name: "ROBOT", Strategy: "heuristic"}.String())
+}
+
+func TestFindRivalry(t *testing.T) {
+	ana := Side{Name: "ANA"}
+	robot := Side{Name: "ROBOT", Strategy: "heuristic"}
+	comeback := statsMatch("heuristic", "ANA", "ROBOT", "ROBOT", "", "ANA", "ANA", "ANA")
+	comeback.EndedAt = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	// ROBOT played first in this one
+	reversed := model.Match{
+		Players:    [2]string{"ROBOT", "ANA"},
+		Strategies: [2]string{"heuristic", ""},
+		Winner:     "ANA",
+		Rounds:     []model.MatchRound{{Winner: "ROBOT"}, {Winner: "ANA"}, {Winner: "ANA"}},
+	}
+	matches := []model.Match{
+		statsMatch("heuristic", "ROBOT", "ROBOT"),
+		comeback,
+		statsMatch("cycler", "ROBOT", "ROBOT"),
+		reversed,
+		{Players: [2]string{"PAUL", "ROBOT"}, Strategies: [2]string{"", "heuristic"}, Winner: "PAUL"},
+	}
+
+	rivalry := FindRivalry(matches, ana, robot)
+	assert.Equal(t, [2]Side{ana, robot}, rivalry.Sides)
+	assert.Equal(t, [2]int{2, 1}, rivalry.Wins)
+	assert.Equal(t, 3, rivalry.Games())
+	assert.Equal(t, 2, rivalry.Streak)
+	assert.Equal(t, 0, rivalry.StreakSide)
+	assert.Equal(t, Comeback{Side: 0, Deficit: 2, Score: [2]int{0, 2}, At: comeback.EndedAt}, rivalry.Comeback)
+
+	other := FindRivalry(matches, robot, ana)
+	assert.Equal(t, [2]int{1, 2}, other.Wins)
+	assert.Equal(t, 1, other.StreakSide)
+	assert.Equal(t, [2]int{2, 0}, other.Comeback.Score)
+
+	none := FindRivalry(matches, ana, Side{Name: "ZOE"})
+	assert.Equal(t, 0, none.Games())
+	assert.Equal(t, 0, none.Comeback.Deficit)
+}
@@ -0,0 +1,144 @@
+package history
+
+import (
+	"sort"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+// UnknownStrategy labels the opponents of matches recorded without their strategy.
+const UnknownStrategy = "unknown"
+
+// Stats summarizes the matches of a player, from their point of view.
+type Stats struct {
+	Player string
+	Games  simulation.Outcome
+	Rounds simulation.Outcome
+	// Moves counts the moves of the player, indexed by move - 1.
+	Moves [3]int
+	// Transitions counts the next move of the player after each of their moves in the same game,
+	// indexed by previous move - 1 then next move - 1.
+	Transitions [3][3]int
+	// Score is the number of rounds won minus the number of rounds lost after each round.
+	Score []int
+	// LongestWinStreak and LongestLossStreak are the longest runs of rounds won or lost in a row,
+	// draws breaking both.
+	LongestWinStreak  int
+	LongestLossStreak int
+	// LongestGameStreak is the longest run of games won in a row.
+	LongestGameStreak int
+	Opponents         []OpponentStats
+}
+
+// OpponentStats are the results of a player against the opponents of a strategy.
+type OpponentStats struct {
+	Strategy string
+	Games    simulation.Outcome
+	Rounds   simulation.Outcome
+}
+
+// AverageGameLength returns the average number of rounds of a game.
+func (r Stats) AverageGameLength() float64 {
+	if r.Games.Total() == 0 {
+		return 0
+	}
+	return float64(r.Rounds.Total()) / float64(r.Games.Total())
+}
+
+// Summarize computes the stats of the player over the matches, oldest first.
+func Summarize(matches []model.Match, player string) Stats {
+	stats := Stats{Player: player}
+	opponents := map[string]*OpponentStats{}
+	winStreak, lossStreak, gameStreak := 0, 0, 0
+	for _, match := range matches {
+		side := 0
+		switch {
+		case match.Players[0] == player:
+		case match.Players[1] == player:
+			side = 1
+		default:
+			continue
+		}
+		self, other := match.Players[side], match.Players[1-side]
+
+		strategy := match.Strategies[1-side]
+		if strategy == "" {
+			strategy = UnknownStrategy
+		}
+		opponent, ok := opponents[strategy]
+		if !ok {
+			opponent = &OpponentStats{Strategy: strategy}
+			opponents[strategy] = opponent
+		}
+
+		previous := model.Move(0)
+		for _, round := range match.Rounds {
+			if move := round.Moves[side]; move.Valid() {
+				stats.Moves[move-1]++
+				if previous.Valid() {
+					stats.Transitions[previous-1][move-1]++
+				}
+				previous = move
+			}
+			switch round.Winner {
+			case self:
+				stats.Rounds.Wins++
+				opponent.Rounds.Wins++
+				winStreak, lossStreak = winStreak+1, 0
+			case other:
+				stats.Rounds.Losses++
+				opponent.Rounds.Losses++
+				winStreak, lossStreak = 0, lossStreak+1
+			default:
+				stats.Rounds.Draws++
+				opponent.Rounds.Draws++
+				winStreak, lossStreak = 0, 0
+			}
+			stats.Score = append(stats.Score, stats.Rounds.Wins-stats.Rounds.Losses)
+			stats.LongestWinStreak = max(stats.LongestWinStreak, winStreak)
+			stats.LongestLossStreak = max(stats.LongestLossStreak, lossStreak)
+		}
+
+		if match.Winner == self {
+			stats.Games.Wins++
+			opponent.Games.Wins++
+			gameStreak++
+		} else {
+			stats.Games.Losses++
+			opponent.Games.Losses++
+			gameStreak = 0
+		}
+		stats.LongestGameStreak = max(stats.LongestGameStreak, gameStreak)
+	}
+
+	for _, opponent := range opponents {
+		stats.Opponents = append(stats.Opponents, *opponent)
+	}
+	sort.Slice(stats.Opponents, func(i, j int) bool {
+		a, b := stats.Opponents[i], stats.Opponents[j]
+		if a.Games.Total() != b.Games.Total() {
+			return a.Games.Total() > b.Games.Total()
+		}
+		return a.Strategy < b.Strategy
+	})
+	return stats
+}
+
+// Humans returns the players of the matches recorded without a strategy, in alphabetical order.
+func Humans(matches []model.Match) []string {
+	seen := map[string]bool{}
+	for _, match := range matches {
+		for side, name := range match.Players {
+			if match.Strategies[side] == "" {
+				seen[name] = true
+			}
+		}
+	}
+	humans := make([]string, 0, len(seen))
+	for name := range seen {
+		humans = append(humans, name)
+	}
+	sort.Strings(humans)
+	return humans
+}
@@ -0,0 +1,69 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+)
+
+// statsMatch is a match of ANA against ROBOT, whose rounds are won by the given player names.
+func statsMatch(strategy, winner string, rounds ...string) model.Match {
+	match := model.Match{Players: [2]string{"ANA", "ROBOT"}, Strategies: [2]string{"", strategy}, Winner: winner}
+	for _, roundWinner := range rounds {
+		moves := [2]model.Move{model.Rock, model.Rock}
+		switch roundWinner {
+		case "ANA":
+			moves[0] = model.Paper
+		case "ROBOT":
+			moves[1] = model.Paper
+		}
+		match.Rounds = append(match.Rounds, model.MatchRound{Moves: moves, Winner: roundWinner})
+	}
+	return match
+}
+
+func TestSummarize(t *testing.T) {
+	matches := []model.Match{
+		statsMatch("heuristic", "ANA", "ANA", "", "ANA"),
+		statsMatch("heuristic", "ANA", "ROBOT", "ANA"),
+		statsMatch("", "ROBOT", "ROBOT", "ROBOT", "ROBOT"),
+		{Players: [2]string{"PAUL", "ROBOT"}, Winner: "PAUL"},
+	}
+
+	stats := Summarize(matches, "ANA")
+	assert.Equal(t, simulation.Outcome{Wins: 2, Losses: 1}, stats.Games)
+	assert.Equal(t, simulation.Outcome{Wins: 3, Draws: 1, Losses: 4}, stats.Rounds)
+	assert.Equal(t, [3]int{5, 3, 0}, stats.Moves)
+	assert.Equal(t, [3][3]int{{2, 2, 0}, {1, 0, 0}, {}}, stats.Transitions)
+	assert.Equal(t, []int{1, 1, 2, 1, 2, 1, 0, -1}, stats.Score)
+	assert.Equal(t, 1, stats.LongestWinStreak)
+	assert.Equal(t, 3, stats.LongestLossStreak)
+	assert.Equal(t, 2, stats.LongestGameStreak)
+	assert.InDelta(t, 8.0/3, stats.AverageGameLength(), 1e-9)
+	assert.Equal(t, []OpponentStats{
+		{Strategy: "heuristic", Games: simulation.Outcome{Wins: 2}, Rounds: simulation.Outcome{Wins: 3, Draws: 1, Losses: 1}},
+		{Strategy: UnknownStrategy, Games: simulation.Outcome{Losses: 1}, Rounds: simulation.Outcome{Losses: 3}},
+	}, stats.Opponents)
+
+	robot := Summarize(matches, "ROBOT")
+	assert.Equal(t, simulation.Outcome{Wins: 1, Losses: 3}, robot.Games)
+	assert.Equal(t, [3]int{4, 4, 0}, robot.Moves)
+	assert.Equal(t, UnknownStrategy, robot.Opponents[0].Strategy, "the human has no strategy")
+
+	none := Summarize(matches, "ZOE")
+	assert.Equal(t, 0, none.Games.Total())
+	assert.Equal(t, 0.0, none.AverageGameLength())
+}
+
+func TestHumans(t *testing.T) {
+	matches := []model.Match{
+		statsMatch("heuristic", "ANA"),
+		{Players: [2]string{"PAUL", "ANA"}, Winner: "PAUL"},
+		{Players: [2]string{"CYCLER", "ZOE"}, Strategies: [2]string{"cycler", ""}, Winner: "ZOE"},
+	}
+	assert.Equal(t, []string{"ANA", "PAUL", "ZOE"}, Humans(matches))
+	assert.Empty(t, Humans(nil))
+}
@@ -4,20 +4,31 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yuripiffer/rock-paper-scissors/history"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/profiles"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
 	"github.com/yuripiffer/rock-paper-scissors/testutils"
 )
 
+// revealLine matches the computer revealing its move, printed between the round and the game results.
+var revealLine = regexp.MustCompile(`ROBOT reveals nonce [0-9a-f]+: sha256\("[a-z]+:[0-9a-f]+"\) = [0-9a-f]+, as committed\n`)
+
 func Test_main(t *testing.T) {
 	restoreTimeSpan := testutils.IgnoreSleep()
 	defer restoreTimeSpan()
+	dataHome := t.TempDir()
+	t.Setenv("XDG_DATA_HOME", dataHome)
 
 	tests := []struct {
 		name            string
+		opts            options
 		input           string
 		randomizerMoves []int
 		winnerMessage   string
@@ -37,7 +48,8 @@ func Test_main(t *testing.T) {
 		},
 		{
 			name: "Two games, one with 3 rounds, another with 1 round",
-			input: "1\n" + // Unsuccessfully tries to set the name as "1"
+			input: "2\n" + // creates a new profile instead of choosing Ana's
+				"1\n" + // Unsuccessfully tries to set the name as "1"
 				"\n" + // fails again trying to set the name as empty
 				"Paul\n" + // sets name as Paul
 				"\n" + //doesn't select winning score (default is 3)
@@ -56,6 +68,29 @@ func Test_main(t *testing.T) {
 				"Scissors beats Paper, \u001B[1;31mPAUL\u001B[0m wins the round!\n\n" +
 				"\u001B[1;31mPAUL\u001B[0m is the WINNER of the game!!!"),
 		},
+		{
+			name: "explain mode shows the computer reasoning",
+			opts: options{explain: true},
+			input: "1\n" + // Ana chooses her profile
+				"1\n" + // chooses winning score as 1
+				"2\n" + // plays paper, computer plays scissors
+				"0\n" + // selects to exit the game
+				"Y\n", // and confirms
+			randomizerMoves: []int{3},
+			winnerMessage:   "ROBOT explains: nobody won last round, so I played Scissors at random",
+		},
+		{
+			name: "ghost mode without recorded rounds plays at random",
+			opts: options{ghost: true, explain: true},
+			input: "3\n" + // Zoe creates a new profile, she never played before
+				"Zoe\n" + // and inputs her name
+				"1\n" + // chooses winning score as 1
+				"2\n" + // plays paper, the ghost plays scissors
+				"0\n" + // selects to exit the game
+				"Y\n", // and confirms
+			randomizerMoves: []int{3},
+			winnerMessage:   "ZOE has no recorded rounds yet, so the ghost plays at random.",
+		},
 	}
 	origStdin := os.Stdin
 	defer func() { os.Stdin = origStdin }()
@@ -84,11 +119,28 @@ func Test_main(t *testing.T) {
 			os.Stdin = r
 
 			output, err := testutils.CaptureStdout(func() {
-				runProgram(mockRandomizer)
+				runProgram(mockRandomizer, tt.opts)
 			})
 
 			assert.NoError(t, err)
-			assert.Contains(t, output, tt.winnerMessage)
+			assert.Contains(t, output, "ROBOT has chosen its move, commitment ")
+			assert.Contains(t, revealLine.ReplaceAllString(output, ""), tt.winnerMessage)
 		})
 	}
+
+	_, err := os.Stat(filepath.Join(dataHome, "rock-paper-scissors", "opponents", "PAUL.json"))
+	assert.NoError(t, err, "the profile of the player is saved at exit")
+
+	matches, err := history.NewFile(filepath.Join(dataHome, "rock-paper-scissors", history.FileName)).Matches()
+	assert.NoError(t, err)
+	assert.Len(t, matches, 4, "every finished game is kept in the history")
+
+	ana, err := profiles.NewStore(filepath.Join(dataHome, "rock-paper-scissors", "profiles")).Load("Ana")
+	assert.NoError(t, err)
+	assert.Equal(t, "Ana", ana.DisplayName)
+	assert.Equal(t, profiles.Stats{Games: 2, Rounds: 2}, ana.Stats, "the stats of the profile are saved at exit")
+
+	ratings, err := rating.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, ratings.Matches, "the ratings are updated after every finished game")
 }
@@ -3,23 +3,110 @@ package main
 import (
 	"bufio"
 	"context"
-	"math/rand"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/yuripiffer/rock-paper-scissors/cli"
+	"github.com/yuripiffer/rock-paper-scissors/commands"
 	"github.com/yuripiffer/rock-paper-scissors/game"
+	"github.com/yuripiffer/rock-paper-scissors/history"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
 	"github.com/yuripiffer/rock-paper-scissors/players"
+	"github.com/yuripiffer/rock-paper-scissors/profiles"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/rating"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
 )
 
+// options holds the command line flags of the game.
+type options struct {
+	explain    bool
+	opponent   string
+	strategy   string
+	ghost      bool
+	bot        string
+	botTimeout time.Duration
+	random     string
+	seed       int64
+	record     string
+	transcript string
+}
+
 func main() {
-	randomizer := rand.New(rand.NewSource(time.Now().UnixNano()))
+	if store, err := history.Open(); err == nil {
+		// ghost specs imitate the players of the match history, in the game and in the commands
+		strategies.RegisterGhost(store)
+	}
+	if len(os.Args) > 1 {
+		if command, ok := commands.Lookup(os.Args[1]); ok {
+			if err := command.Run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	opts := options{}
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] | <command> [args]\n\nFlags:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprintln(flag.CommandLine.Output())
+		commands.PrintUsage(flag.CommandLine.Output())
+	}
+	flag.BoolVar(&opts.explain, "explain", false, "explain the computer moves after each round")
+	flag.StringVar(&opts.opponent, "opponent", "", "built-in strategy to play against instead of the computer, e.g. copycat")
+	flag.StringVar(&opts.strategy, "strategy", "", "built-in strategy the computer plays instead of its heuristic, e.g. qlearning")
+	flag.BoolVar(&opts.ghost, "ghost", false, "the computer imitates your moves from the match history, so you play against yourself")
+	flag.StringVar(&opts.bot, "bot", "", "command of an external bot to play against instead of the computer")
+	flag.DurationVar(&opts.botTimeout, "bot-timeout", 2*time.Second, "time the external bot has to answer")
+	flag.StringVar(&opts.random, "random", random.SourcePCG, "source of randomness: crypto, pcg, chacha8 or replay:<file>")
+	flag.Int64Var(&opts.seed, "seed", 0, "seed of the pcg and chacha8 sources (default: the clock), printed at exit")
+	flag.StringVar(&opts.record, "record", "", "file to record the random numbers drawn, to replay them with --random replay:<file>")
+	flag.StringVar(&opts.transcript, "transcript", "", "file to write the computer's revealed commitments, to check with the verify command")
+	flag.Parse()
 
-	runProgram(randomizer)
+	seeded := false
+	flag.Visit(func(f *flag.Flag) { seeded = seeded || f.Name == "seed" })
+	if !seeded {
+		opts.seed = time.Now().UnixNano()
+	}
+	randomizer, closeRandomizer, err := initRandomizer(opts, seeded)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer closeRandomizer()
+
+	runProgram(randomizer, opts)
+
+	if random.Seeded(opts.random) {
+		fmt.Printf("Seed %d: replay this session with --random %s --seed %d\n", opts.seed, opts.random, opts.seed)
+	}
 }
 
-func runProgram(randomizer model.Randomizer) {
+// initRandomizer creates the configured source of randomness, recording its numbers when asked to.
+func initRandomizer(opts options, seeded bool) (model.Randomizer, func(), error) {
+	if seeded && !random.Seeded(opts.random) {
+		return nil, nil, fmt.Errorf("the %s source cannot be seeded", opts.random)
+	}
+	randomizer, err := random.New(opts.random, opts.seed)
+	if err != nil || opts.record == "" {
+		return randomizer, func() {}, err
+	}
+	f, err := os.Create(opts.record)
+	if err != nil {
+		return nil, nil, err
+	}
+	return random.NewRecorder(randomizer, f), func() { _ = f.Close() }, nil
+}
+
+func runProgram(randomizer model.Randomizer, opts options) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	exitChan := make(chan struct{}, 1)
@@ -31,20 +118,158 @@ func runProgram(randomizer model.Randomizer) {
 
 	cliInput := cli.InitInput(scanner, exitChan)
 	rockPaperScissorsGame := game.InitGame(cliInput, throw)
+	store, err := history.Open()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rockPaperScissorsGame.SetStore(rating.NewStore(store))
+	if random.Seeded(opts.random) {
+		rockPaperScissorsGame.SetSeed(opts.seed)
+	}
 
-	computerPlayer := players.InitComputerPlayer(throw, randomizer)
+	opponent, err := initOpponent(throw, randomizer, opts)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if closer, ok := opponent.(io.Closer); ok {
+		defer func() { _ = closer.Close() }()
+	}
+
+	profileStore, err := profiles.Open()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 	humanPlayer := players.InitHumanPlayer(cliInput)
+	humanPlayer.SetProfiles(profileStore)
 	humanPlayer.SetName()
 
 	if humanPlayer.GetName() == "" {
 		return
 	}
+	playerProfile := humanPlayer.Profile()
+	if err = cli.SetTheme(playerProfile.Theme); err != nil {
+		fmt.Println(err)
+	}
+	defer saveProfileStats(profileStore, playerProfile, store)
+	if computerPlayer, ok := opponent.(*players.Computer); ok {
+		computerPlayer.SetDifficulty(playerProfile.Difficulty)
+		profile, err := initProfile(computerPlayer, humanPlayer.GetName(), randomizer, store, opts)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		defer func() {
+			if err := opponents.Save(profile); err != nil {
+				fmt.Println(err)
+			}
+		}()
+		if opts.transcript != "" {
+			f, err := os.Create(opts.transcript)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			defer func() { _ = f.Close() }()
+			computerPlayer.SetTranscript(f)
+		}
+	}
 
+	playUntilExit(ctx, cancel, exitChan, func(ctx context.Context) {
+		rockPaperScissorsGame.Play(ctx, humanPlayer, opponent)
+	})
+}
+
+// playUntilExit runs play until the player exits, then cancels it and waits for it to return, so the
+// deferred saves do not race with the game still observing the rounds.
+func playUntilExit(ctx context.Context, cancel context.CancelFunc, exitChan <-chan struct{}, play func(ctx context.Context)) {
+	done := make(chan struct{})
 	go func() {
-		rockPaperScissorsGame.Play(ctx, humanPlayer, computerPlayer)
+		defer close(done)
+		play(ctx)
 	}()
 
 	<-exitChan
 	cancel()
-	time.Sleep(model.Span.Time500ms)
+	<-done
+}
+
+// initOpponent creates the computer player, or the built-in strategy or external bot when one is configured.
+func initOpponent(throw *game.Throw, randomizer model.Randomizer, opts options) (model.Player, error) {
+	switch {
+	case opts.bot != "":
+		return players.InitExternalPlayer(strings.Fields(opts.bot), opts.botTimeout, os.Stdout)
+	case opts.opponent != "":
+		strategy, err := strategies.New(opts.opponent, randomizer)
+		if err != nil {
+			return nil, err
+		}
+		return players.InitBotPlayer(strategy), nil
+	}
+	computerPlayer := players.InitComputerPlayer(throw, randomizer)
+	computerPlayer.SetExplain(opts.explain)
+	switch {
+	case opts.strategy != "":
+		strategy, err := strategies.New(opts.strategy, randomizer)
+		if err != nil {
+			return nil, err
+		}
+		computerPlayer.SetStrategy(strategy)
+	case !opts.ghost:
+		// the table trained with the train command, if any, replaces the heuristic, but neither the
+		// difficulty nor the opponent model
+		trained, err := strategies.LoadTrained(randomizer)
+		if err != nil {
+			return nil, err
+		}
+		if trained != nil {
+			computerPlayer.SetTrained(trained)
+		}
+	}
+	return computerPlayer, nil
+}
+
+// saveProfileStats updates the stats of the player profile from the match history.
+func saveProfileStats(profileStore *profiles.Store, playerProfile *profiles.Profile, store model.MatchStore) {
+	matches, err := store.Matches()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	stats := history.Summarize(matches, playerProfile.Name)
+	playerProfile.Stats = profiles.Stats{
+		Games:     stats.Games.Total(),
+		Wins:      stats.Games.Wins,
+		Rounds:    stats.Rounds.Total(),
+		RoundWins: stats.Rounds.Wins,
+	}
+	if err = profileStore.Save(playerProfile); err != nil {
+		fmt.Println(err)
+	}
+}
+
+// initProfile loads what the computer learned about the player, and makes the computer imitate the
+// player's recorded matches in ghost mode.
+func initProfile(
+	computerPlayer *players.Computer, name string, randomizer model.Randomizer, store model.MatchStore, opts options,
+) (*opponents.Model, error) {
+	profile, err := opponents.Load(name)
+	if err != nil {
+		return nil, err
+	}
+	computerPlayer.SetOpponentModel(profile)
+	if opts.ghost {
+		matches, err := store.Matches()
+		if err != nil {
+			return nil, err
+		}
+		recorded := opponents.FromMatches(name, matches)
+		if recorded.Rounds() == 0 {
+			fmt.Printf("%s has no recorded rounds yet, so the ghost plays at random.\n", name)
+		}
+		computerPlayer.SetStrategy(opponents.NewGhost(randomizer, recorded))
+	}
+	return profile, nil
 }
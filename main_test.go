@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/game"
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/players"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
+)
+
+func Test_initOpponent(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         options
+		trained      bool
+		wantStrategy string
+	}{
+		{"heuristic without a trained table", options{}, false, "heuristic"},
+		{"trained table loaded at startup", options{}, true, "qlearning"},
+		{"explicit strategy", options{strategy: "cycler"}, true, "cycler"},
+		{"ghost mode sets its own strategy", options{ghost: true}, true, "heuristic"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_DATA_HOME", t.TempDir())
+			if tt.trained {
+				path, err := storage.Path(strategies.QTableFile)
+				assert.NoError(t, err)
+				assert.NoError(t, strategies.SaveQTable(path, strategies.NewQTable(1)))
+			}
+
+			opponent, err := initOpponent(&game.Throw{}, &model.RandomizerMock{}, tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStrategy, opponent.(model.StrategyPlayer).StrategyName())
+		})
+	}
+}
+
+func Test_initProfile_ghost(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	restoreStdout, err := testutils.SilenceStdout()
+	assert.NoError(t, err)
+	defer restoreStdout()
+	// ANA played rock and paper only, as the first and the second player, and PAUL scissors
+	store := history.NewFile(filepath.Join("testdata", "history.jsonl"))
+
+	computer := players.InitComputerPlayer(&game.Throw{}, random.NewPCG(1, 1))
+	profile, err := initProfile(computer, "ANA", random.NewPCG(1, 1), store, options{ghost: true})
+	assert.NoError(t, err)
+	assert.Zero(t, profile.Rounds(), "the ghost does not need the learned profile")
+	assert.Equal(t, "ghost:ANA", computer.StrategyName())
+
+	counts := map[model.Move]int{}
+	for i := 0; i < 100; i++ {
+		computer.SetNextMove()
+		counts[computer.GetMove()]++
+	}
+	assert.Positive(t, counts[model.Rock])
+	assert.Positive(t, counts[model.Paper])
+	assert.Zero(t, counts[model.Scissors], "the ghost imitates the recorded history of ANA only")
+}
+
+func Test_playUntilExit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	exitChan := make(chan struct{}, 1)
+	returned := false
+	playUntilExit(ctx, cancel, exitChan, func(ctx context.Context) {
+		exitChan <- struct{}{}
+		<-ctx.Done()
+		// the game still winds down after the exit
+		time.Sleep(10 * time.Millisecond)
+		returned = true
+	})
+	assert.True(t, returned, "waits for the game to return")
+}
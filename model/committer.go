@@ -0,0 +1,10 @@
+package model
+
+// Committer is implemented by players that commit to their move before the other player chooses,
+// and reveal it after the round so the commitment can be verified.
+type Committer interface {
+	// Commitment returns the commitment of the current move, or an empty string if there is none.
+	Commitment() string
+	// Reveal returns the committed move and the nonce of the commitment.
+	Reveal() (Move, string)
+}
@@ -0,0 +1,14 @@
+package model
+
+// Difficulties of the computer, chosen in the player profile.
+const (
+	// DifficultyEasy makes the computer play at random.
+	DifficultyEasy = "easy"
+	// DifficultyNormal makes the computer play its heuristic and what it learned about the player.
+	DifficultyNormal = "normal"
+	// DifficultyHard makes the computer act on what it learned about the player as soon as it has a favourite.
+	DifficultyHard = "hard"
+)
+
+// Difficulties lists the difficulties from the easiest.
+var Difficulties = []string{DifficultyEasy, DifficultyNormal, DifficultyHard}
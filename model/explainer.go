@@ -0,0 +1,6 @@
+package model
+
+// Explainer interface is implemented by players that can tell why they chose their current move.
+type Explainer interface {
+	Explain() string
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Match is a finished game, as kept in the match history.
+type Match struct {
+	// Version is the schema version of the record, set by the store.
+	Version      int       `json:"version"`
+	Ruleset      string    `json:"ruleset"`
+	Seed         int64     `json:"seed,omitempty"`
+	WinningScore int       `json:"winning_score"`
+	Players      [2]string `json:"players"`
+	// Strategies names the strategies of the automated players, empty for the humans.
+	Strategies [2]string    `json:"strategies"`
+	Winner     string       `json:"winner"`
+	StartedAt  time.Time    `json:"started_at"`
+	EndedAt    time.Time    `json:"ended_at"`
+	Rounds     []MatchRound `json:"rounds"`
+}
+
+// MatchRound is a round of a match, with the moves in the order of the match players.
+type MatchRound struct {
+	Moves [2]Move `json:"moves"`
+	// Winner is the name of the player who won the round, empty on a draw.
+	Winner string    `json:"winner,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// MatchStore keeps the history of the finished matches.
+type MatchStore interface {
+	// Append adds a finished match to the history.
+	Append(match Match) error
+	// Matches returns the matches of the history, oldest first.
+	Matches() ([]Match, error)
+}
@@ -0,0 +1,6 @@
+package model
+
+// RoundObserver interface is implemented by players that need to know how each round ended.
+type RoundObserver interface {
+	ObserveRound(own, opponent Move)
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RulesetClassic is the name of the classic rock, paper & scissors ruleset.
+const RulesetClassic = "classic"
+
+// Moves lists the valid moves of the classic ruleset.
+var Moves = []Move{Rock, Paper, Scissors}
+
+// Valid reports whether the move is one of the playable moves.
+func (m Move) Valid() bool {
+	return m >= Rock && m <= Scissors
+}
+
+// Beats reports whether move a wins against move b.
+// A valid move always beats a missing or invalid one.
+func Beats(a, b Move) bool {
+	if !a.Valid() {
+		return false
+	}
+	if !b.Valid() {
+		return true
+	}
+	return (a-b+3)%3 == 1
+}
+
+// Counter returns the move that beats m.
+func Counter(m Move) Move {
+	return m%3 + 1
+}
+
+// ParseMove converts a move name, case-insensitively, into a Move.
+func ParseMove(s string) (Move, error) {
+	for _, m := range Moves {
+		if strings.EqualFold(strings.TrimSpace(s), MoveToStr[m]) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown move %q", s)
+}
+
+// MarshalText encodes the move by its name, e.g. in the match history. A
+// missing move, e.g. a forfeited round, is encoded as an empty name.
+func (m Move) MarshalText() ([]byte, error) {
+	if m == 0 {
+		return []byte{}, nil
+	}
+	if !m.Valid() {
+		return nil, fmt.Errorf("invalid move %d", int(m))
+	}
+	return []byte(strings.ToLower(MoveToStr[m])), nil
+}
+
+// UnmarshalText decodes a move name, or a missing move from an empty name.
+func (m *Move) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = 0
+		return nil
+	}
+	move, err := ParseMove(string(text))
+	if err != nil {
+		return err
+	}
+	*m = move
+	return nil
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMove_Valid(t *testing.T) {
+	tests := []struct {
+		name string
+		move Move
+		want bool
+	}{
+		{"rock", Rock, true},
+		{"paper", Paper, true},
+		{"scissors", Scissors, true},
+		{"no move", 0, false},
+		{"out of range", 4, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.move.Valid())
+		})
+	}
+}
+
+func TestBeats(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Move
+		want bool
+	}{
+		{"paper beats rock", Paper, Rock, true},
+		{"rock beats scissors", Rock, Scissors, true},
+		{"scissors beats paper", Scissors, Paper, true},
+		{"rock loses to paper", Rock, Paper, false},
+		{"tie", Rock, Rock, false},
+		{"valid move beats no move", Scissors, 0, true},
+		{"no move loses to valid move", 0, Rock, false},
+		{"no move against no move", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Beats(tt.a, tt.b))
+		})
+	}
+}
+
+func TestCounter(t *testing.T) {
+	for _, m := range Moves {
+		assert.True(t, Beats(Counter(m), m))
+	}
+}
+
+func TestParseMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Move
+		wantErr bool
+	}{
+		{"lowercase", "rock", Rock, false},
+		{"mixed case with spaces", " PaPeR ", Paper, false},
+		{"capitalized", "Scissors", Scissors, false},
+		{"unknown", "lizard", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMove(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestMove_MarshalText(t *testing.T) {
+	data, err := json.Marshal([]Move{Rock, Scissors})
+	assert.NoError(t, err)
+	assert.Equal(t, `["rock","scissors"]`, string(data))
+
+	var moves []Move
+	assert.NoError(t, json.Unmarshal([]byte(`["Paper","rock"]`), &moves))
+	assert.Equal(t, []Move{Paper, Rock}, moves)
+
+	data, err = json.Marshal([]Move{Paper, 0})
+	assert.NoError(t, err)
+	assert.Equal(t, `["paper",""]`, string(data))
+	assert.NoError(t, json.Unmarshal(data, &moves))
+	assert.Equal(t, []Move{Paper, 0}, moves)
+
+	_, err = json.Marshal(Move(7))
+	assert.Error(t, err)
+	assert.Error(t, json.Unmarshal([]byte(`"lizard"`), &moves[0]))
+}
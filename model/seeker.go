@@ -0,0 +1,7 @@
+package model
+
+// Seeker is implemented by players that play recorded moves, so a replay can go back and forth.
+type Seeker interface {
+	// Seek makes the player stand before the round, numbered from 0, with the score it had then.
+	Seek(round int)
+}
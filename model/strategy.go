@@ -0,0 +1,14 @@
+package model
+
+// Strategy interface specifies how an automated player chooses its moves from the rounds it observed.
+type Strategy interface {
+	Name() string
+	Next() Move
+	Observe(own, opponent Move)
+	Reset()
+}
+
+// StrategyPlayer is implemented by automated players, to tell which strategy chooses their moves.
+type StrategyPlayer interface {
+	StrategyName() string
+}
@@ -0,0 +1,58 @@
+package opponents
+
+import (
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Ghost imitates a recorded player: it draws each move from the moves the player made after the
+// same last round, or from all of their moves when that round was never seen.
+type Ghost struct {
+	random  model.Randomizer
+	profile Model
+	// lastOwn and lastOpponent are the moves of the last round, from the point of view of the ghost.
+	lastOwn, lastOpponent model.Move
+}
+
+// NewGhost creates a ghost of the profile, which is copied so the ghost does not change while the
+// profile keeps learning.
+func NewGhost(random model.Randomizer, profile *Model) *Ghost {
+	return &Ghost{random: random, profile: *profile}
+}
+
+// Name returns "ghost:" followed by the name of the imitated player, so the ghosts of different
+// players are told apart, e.g. in the ratings.
+func (r *Ghost) Name() string {
+	if r.profile.Name == "" {
+		return "ghost"
+	}
+	return "ghost:" + r.profile.Name
+}
+
+func (r *Ghost) Next() model.Move {
+	counts := r.profile.Moves
+	if r.lastOwn.Valid() && r.lastOpponent.Valid() {
+		if context := r.profile.Transitions[transition(r.lastOwn, r.lastOpponent)]; context[0]+context[1]+context[2] > 0 {
+			counts = context
+		}
+	}
+	total := counts[0] + counts[1] + counts[2]
+	if total == 0 {
+		return model.Moves[r.random.Intn(len(model.Moves))]
+	}
+	n := r.random.Intn(total)
+	for i, count := range counts {
+		if n < count {
+			return model.Moves[i]
+		}
+		n -= count
+	}
+	return model.Moves[len(model.Moves)-1]
+}
+
+func (r *Ghost) Observe(own, opponent model.Move) {
+	r.lastOwn, r.lastOpponent = own, opponent
+}
+
+func (r *Ghost) Reset() {
+	r.lastOwn, r.lastOpponent = 0, 0
+}
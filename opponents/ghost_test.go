@@ -0,0 +1,46 @@
+package opponents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+)
+
+func TestGhost(t *testing.T) {
+	profile := NewModel("ANA")
+	for i := 0; i < 30; i++ {
+		// opens with rock, then always plays what beats the computer's last move
+		profile.Observe(model.Rock, model.Scissors)
+		profile.Observe(model.Rock, model.Paper)
+		profile.Observe(model.Scissors, model.Rock)
+		profile.NewGame()
+	}
+
+	ghost := NewGhost(random.NewPCG(1, 1), profile)
+	assert.Equal(t, "ghost:ANA", ghost.Name())
+	profile.Reset()
+
+	ghost.Observe(model.Rock, model.Paper)
+	assert.Equal(t, model.Scissors, ghost.Next(), "imitates the move recorded after the same round")
+
+	ghost.Observe(model.Paper, model.Paper)
+	counts := map[model.Move]int{}
+	for i := 0; i < 3000; i++ {
+		counts[ghost.Next()]++
+	}
+	assert.InDelta(t, 2000, counts[model.Rock], 150, "imitates the overall distribution after an unseen round")
+	assert.InDelta(t, 1000, counts[model.Scissors], 150)
+	assert.Zero(t, counts[model.Paper])
+
+	ghost.Reset()
+	assert.NotEqual(t, model.Paper, ghost.Next())
+}
+
+func TestGhost_withoutRecords(t *testing.T) {
+	ghost := NewGhost(&model.RandomizerMock{IntnFunc: func(n int) int { return 1 }}, NewModel("BOB"))
+	assert.Equal(t, model.Paper, ghost.Next(), "plays at random")
+	assert.Equal(t, "ghost:BOB", ghost.Name())
+}
@@ -0,0 +1,163 @@
+// Package opponents learns how human players play and keeps what it learned between sessions.
+package opponents
+
+import (
+	"fmt"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// ModelVersion is the version of the profile file format.
+const ModelVersion = 1
+
+const (
+	// minSamples is the number of observations a prediction needs before it is trusted.
+	minSamples = 5
+	// minConfidence is the probability the predicted move needs before it is trusted.
+	minConfidence = 0.5
+)
+
+// Shifts of a move relative to the previous move of the same player.
+const (
+	ShiftStay = iota
+	ShiftUp   // plays what beats the previous move
+	ShiftDown // plays what the previous move beats
+)
+
+// Outcomes of a round from the point of view of the modelled player, as indexes of Model.Shifts.
+const (
+	OutcomeWin = iota
+	OutcomeDraw
+	OutcomeLoss
+)
+
+// Model is the learned behavior of a human player.
+type Model struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	// Moves counts the rock, paper and scissors of the player.
+	Moves [3]int `json:"moves"`
+	// Transitions counts the next move of the player after each pair of moves of the last round,
+	// indexed by (player move-1)*3 + (opponent move-1).
+	Transitions [9][3]int `json:"transitions"`
+	// Shifts counts whether the player stays, shifts up or shifts down after a win, draw or loss.
+	Shifts [3][3]int `json:"shifts"`
+
+	// lastOwn and lastOpponent are the moves of the last round of the current game.
+	lastOwn, lastOpponent model.Move
+}
+
+// Prediction is the probable next move of the player.
+type Prediction struct {
+	Move model.Move
+	// Probability is the observed frequency of Move in Context.
+	Probability float64
+	// Context describes the situation the frequency was observed in.
+	Context string
+	Samples int
+}
+
+// NewModel creates an empty model of the named player.
+func NewModel(name string) *Model {
+	return &Model{Version: ModelVersion, Name: name}
+}
+
+// FromMatches builds the model of the named player from the rounds they played in the matches,
+// oldest first.
+func FromMatches(name string, matches []model.Match) *Model {
+	profile := NewModel(name)
+	for _, match := range matches {
+		for side, player := range match.Players {
+			if player != name {
+				continue
+			}
+			profile.NewGame()
+			for _, round := range match.Rounds {
+				profile.Observe(round.Moves[side], round.Moves[1-side])
+			}
+			break
+		}
+	}
+	profile.NewGame()
+	return profile
+}
+
+// Observe records a round of the player, own being the player move.
+func (r *Model) Observe(own, opponent model.Move) {
+	if !own.Valid() || !opponent.Valid() {
+		return
+	}
+	r.Moves[own-1]++
+	if r.lastOwn.Valid() {
+		r.Transitions[transition(r.lastOwn, r.lastOpponent)][own-1]++
+		r.Shifts[outcome(r.lastOwn, r.lastOpponent)][shift(r.lastOwn, own)]++
+	}
+	r.lastOwn, r.lastOpponent = own, opponent
+}
+
+// NewGame forgets the last round, as the first move of a game does not follow it.
+func (r *Model) NewGame() {
+	r.lastOwn, r.lastOpponent = 0, 0
+}
+
+// Rounds returns the number of observed rounds.
+func (r *Model) Rounds() int {
+	return r.Moves[0] + r.Moves[1] + r.Moves[2]
+}
+
+// Predict returns the most frequent next move of the player after the last round, or overall at
+// the start of a game. It returns false while there are too few observations or no clear favourite.
+func (r *Model) Predict() (Prediction, bool) {
+	counts, context := r.Moves, "overall"
+	if r.lastOwn.Valid() {
+		counts = r.Transitions[transition(r.lastOwn, r.lastOpponent)]
+		context = fmt.Sprintf("after %s against %s", model.MoveToStr[r.lastOwn], model.MoveToStr[r.lastOpponent])
+	}
+
+	total, best := 0, 0
+	for i, count := range counts {
+		total += count
+		if count > counts[best] {
+			best = i
+		}
+	}
+	if total < minSamples {
+		return Prediction{}, false
+	}
+	prediction := Prediction{
+		Move:        model.Moves[best],
+		Probability: float64(counts[best]) / float64(total),
+		Context:     context,
+		Samples:     total,
+	}
+	return prediction, prediction.Probability >= minConfidence
+}
+
+// Reset forgets everything learned about the player.
+func (r *Model) Reset() {
+	*r = Model{Version: ModelVersion, Name: r.Name}
+}
+
+func transition(own, opponent model.Move) int {
+	return int(own-1)*3 + int(opponent-1)
+}
+
+func outcome(own, opponent model.Move) int {
+	switch {
+	case model.Beats(own, opponent):
+		return OutcomeWin
+	case model.Beats(opponent, own):
+		return OutcomeLoss
+	}
+	return OutcomeDraw
+}
+
+func shift(previous, next model.Move) int {
+	switch next {
+	case previous:
+		return ShiftStay
+	case model.Counter(previous):
+		return ShiftUp
+	}
+	return ShiftDown
+}
@@ -0,0 +1,85 @@
+package opponents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestModel_Observe(t *testing.T) {
+	m := NewModel("ANA")
+	m.Observe(model.Rock, model.Scissors)  // win
+	m.Observe(model.Rock, model.Paper)     // stays after a win, loses
+	m.Observe(model.Scissors, model.Paper) // shifts down after a loss
+	m.Observe(0, model.Paper)              // forfeits are ignored
+
+	assert.Equal(t, 3, m.Rounds())
+	assert.Equal(t, [3]int{2, 0, 1}, m.Moves)
+	assert.Equal(t, [3]int{1, 0, 0}, m.Transitions[transition(model.Rock, model.Scissors)])
+	assert.Equal(t, [3]int{0, 0, 1}, m.Transitions[transition(model.Rock, model.Paper)])
+	assert.Equal(t, [3]int{1, 0, 0}, m.Shifts[OutcomeWin])
+	assert.Equal(t, [3]int{0, 0, 1}, m.Shifts[OutcomeLoss])
+
+	m.NewGame()
+	m.Observe(model.Paper, model.Paper)
+	assert.Equal(t, [3]int{2, 1, 1}, m.Moves)
+	assert.Equal(t, [3]int{}, m.Transitions[transition(model.Scissors, model.Paper)], "a new game does not follow the last round")
+
+	m.Reset()
+	assert.Equal(t, NewModel("ANA"), m)
+}
+
+func TestModel_Predict(t *testing.T) {
+	m := NewModel("ANA")
+	_, ok := m.Predict()
+	assert.False(t, ok, "nothing observed")
+
+	for i := 0; i < 3; i++ {
+		m.Observe(model.Rock, model.Scissors)
+		m.Observe(model.Paper, model.Rock)
+		m.NewGame()
+	}
+	prediction, ok := m.Predict()
+	assert.True(t, ok)
+	assert.Equal(t, Prediction{Move: model.Rock, Probability: 0.5, Context: "overall", Samples: 6}, prediction)
+
+	m.Observe(model.Rock, model.Scissors)
+	prediction, ok = m.Predict()
+	assert.False(t, ok, "too few samples after this round")
+	assert.Zero(t, prediction)
+
+	for i := 0; i < 4; i++ {
+		m.Observe(model.Paper, model.Rock)
+		m.NewGame()
+		m.Observe(model.Rock, model.Scissors)
+	}
+	prediction, ok = m.Predict()
+	assert.True(t, ok)
+	assert.Equal(t, Prediction{Move: model.Paper, Probability: 1, Context: "after Rock against Scissors", Samples: 7}, prediction)
+}
+
+func TestFromMatches(t *testing.T) {
+	matches := []model.Match{
+		{Players: [2]string{"ANA", "ROBOT"}, Rounds: []model.MatchRound{
+			{Moves: [2]model.Move{model.Rock, model.Scissors}},
+			{Moves: [2]model.Move{model.Paper, model.Paper}},
+		}},
+		{Players: [2]string{"PAUL", "ROBOT"}, Rounds: []model.MatchRound{{Moves: [2]model.Move{model.Scissors, model.Paper}}}},
+		// a forfeited round is skipped
+		{Players: [2]string{"CYCLER", "ANA"}, Rounds: []model.MatchRound{
+			{Moves: [2]model.Move{model.Rock, model.Paper}},
+			{Moves: [2]model.Move{0, model.Rock}},
+		}},
+	}
+
+	profile := FromMatches("ANA", matches)
+	assert.Equal(t, "ANA", profile.Name)
+	assert.Equal(t, [3]int{1, 2, 0}, profile.Moves)
+	assert.Equal(t, 3, profile.Rounds())
+	assert.Equal(t, [3]int{0, 1, 0}, profile.Transitions[transition(model.Rock, model.Scissors)])
+	assert.Equal(t, [3]int{}, profile.Transitions[transition(model.Paper, model.Paper)], "a new game does not follow the last one")
+
+	assert.Zero(t, FromMatches("ZOE", matches).Rounds())
+}
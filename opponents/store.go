@@ -0,0 +1,86 @@
+package opponents
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// dirName is the directory of the profiles, inside the data directory.
+const dirName = "opponents"
+
+// Path returns the profile file of the named player, see storage.NamedPath.
+func Path(name string) (string, error) {
+	dir, err := storage.Path(dirName)
+	if err != nil {
+		return "", err
+	}
+	return storage.NamedPath(dir, name)
+}
+
+// Load reads the profile of the named player, or returns an empty model when there is none yet.
+func Load(name string) (*Model, error) {
+	path, err := Path(name)
+	if err != nil {
+		return nil, err
+	}
+	m := NewModel(name)
+	err = storage.LoadJSON(path, m)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return NewModel(name), nil
+	case err != nil:
+		return nil, err
+	case m.Version != ModelVersion:
+		return nil, fmt.Errorf("%s: unsupported profile version %d, want %d", path, m.Version, ModelVersion)
+	}
+	return m, nil
+}
+
+// Save writes the profile of the player.
+func Save(m *Model) error {
+	path, err := Path(m.Name)
+	if err != nil {
+		return err
+	}
+	return storage.SaveJSON(path, m)
+}
+
+// Delete removes the profile of the named player.
+func Delete(name string) error {
+	path, err := Path(name)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("no profile for %s", name)
+	}
+	return err
+}
+
+// List returns the saved profiles, sorted by name.
+func List() ([]*Model, error) {
+	dir, err := storage.Path(dirName)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*Model, 0, len(paths))
+	for _, path := range paths {
+		m := &Model{}
+		if err = storage.LoadJSON(path, m); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		models = append(models, m)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	return models, nil
+}
@@ -0,0 +1,49 @@
+package opponents
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+func TestStore(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	m, err := Load("ANA")
+	assert.NoError(t, err)
+	assert.Equal(t, NewModel("ANA"), m, "an unknown player starts with an empty model")
+
+	m.Observe(model.Rock, model.Paper)
+	assert.NoError(t, Save(m))
+	assert.NoError(t, Save(NewModel("../BOB")))
+
+	loaded, err := Load("ANA")
+	assert.NoError(t, err)
+	assert.Equal(t, m.Moves, loaded.Moves)
+
+	path, err := Path("../BOB")
+	assert.NoError(t, err)
+	dir, err := storage.Path(dirName)
+	assert.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(path), "names cannot escape the directory")
+	assert.True(t, strings.HasPrefix(filepath.Base(path), "___BOB."))
+
+	profiles, err := List()
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 2)
+	assert.Equal(t, "../BOB", profiles[0].Name)
+	assert.Equal(t, "ANA", profiles[1].Name)
+
+	assert.NoError(t, Delete("ANA"))
+	assert.Error(t, Delete("ANA"))
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"version": 99}`), 0o644))
+	_, err = Load("../BOB")
+	assert.ErrorContains(t, err, "unsupported profile version")
+}
@@ -0,0 +1,64 @@
+package players
+
+import (
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Bot is the implementation of Player for the built-in strategies.
+type Bot struct {
+	name     string
+	move     model.Move
+	strategy model.Strategy
+	score    int
+}
+
+func InitBotPlayer(strategy model.Strategy) *Bot {
+	b := Bot{
+		strategy: strategy,
+	}
+	b.SetName()
+	return &b
+}
+
+func (r *Bot) SetName() {
+	r.name = strings.ToUpper(r.strategy.Name())
+}
+
+func (r *Bot) GetName() string {
+	return r.name
+}
+
+func (r *Bot) GetMove() model.Move {
+	return r.move
+}
+
+func (r *Bot) SetNextMove() {
+	r.move = r.strategy.Next()
+}
+
+// StrategyName returns the name of the built-in strategy.
+func (r *Bot) StrategyName() string {
+	return r.strategy.Name()
+}
+
+// ObserveRound lets the strategy learn from the finished round.
+func (r *Bot) ObserveRound(own, opponent model.Move) {
+	r.strategy.Observe(own, opponent)
+}
+
+func (r *Bot) IncrementScore() {
+	r.score += 1
+}
+
+func (r *Bot) GetScore() int {
+	return r.score
+}
+
+// ResetScore starts a new game, so the strategy forgets the previous one.
+func (r *Bot) ResetScore() {
+	r.score = 0
+	r.move = 0
+	r.strategy.Reset()
+}
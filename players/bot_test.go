@@ -0,0 +1,48 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+func TestInitBotPlayer(t *testing.T) {
+	b := InitBotPlayer(strategies.NewCycler())
+	assert.Equal(t, "CYCLER", b.GetName())
+	assert.Equal(t, "cycler", b.StrategyName())
+}
+
+func TestBot_SetNextMove(t *testing.T) {
+	b := InitBotPlayer(strategies.NewCopycat(&model.RandomizerMock{}))
+	b.SetNextMove()
+	assert.Equal(t, model.Rock, b.GetMove())
+
+	b.ObserveRound(b.GetMove(), model.Scissors)
+	b.SetNextMove()
+	assert.Equal(t, model.Scissors, b.GetMove())
+}
+
+func TestBot_IncrementScore(t *testing.T) {
+	b := InitBotPlayer(strategies.NewCycler())
+	b.IncrementScore()
+	b.IncrementScore()
+	assert.Equal(t, 2, b.GetScore())
+}
+
+func TestBot_ResetScore(t *testing.T) {
+	b := InitBotPlayer(strategies.NewCycler())
+	b.SetNextMove()
+	b.SetNextMove()
+	b.IncrementScore()
+
+	b.ResetScore()
+	assert.Zero(t, b.GetScore())
+	assert.Zero(t, b.GetMove())
+
+	// the strategy starts over too
+	b.SetNextMove()
+	assert.Equal(t, model.Rock, b.GetMove())
+}
@@ -1,19 +1,41 @@
 package players
 
 import (
+	"fmt"
+	"io"
+
+	"github.com/yuripiffer/rock-paper-scissors/fairness"
 	"github.com/yuripiffer/rock-paper-scissors/game"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
 )
 
 const computerName string = "ROBOT"
 
 // Computer is an automated implementation of Player.
 type Computer struct {
-	name   string
-	move   model.Move
-	random model.Randomizer
-	throw  *game.Throw
-	score  int
+	name    string
+	move    model.Move
+	random  model.Randomizer
+	throw   *game.Throw
+	score   int
+	explain bool
+	reason  string
+	// strategy replaces the built-in heuristic when set.
+	strategy model.Strategy
+	// trained replaces the built-in heuristic when set, but the difficulty and the opponent model come first.
+	trained model.Strategy
+	// opponent is the learned model of the human, used when it predicts the next move confidently.
+	opponent *opponents.Model
+	// difficulty is one of the model difficulties, normal when empty.
+	difficulty string
+	// nonce and commitment prove the move was chosen before the opponent's, see Commitment.
+	nonce      string
+	commitment string
+	// transcript receives the commitments and their reveals, round is the number of the last commitment.
+	transcript io.Writer
+	round      int
 }
 
 func InitComputerPlayer(throw *game.Throw, randomizer model.Randomizer) *Computer {
@@ -38,26 +60,153 @@ func (r *Computer) GetMove() model.Move {
 }
 
 func (r *Computer) SetNextMove() {
-	switch r.throw.WinnerName {
-	case "":
-		// it was a tie, so generates a random throw
-		// Intn(3) will return 0, 1 or 2 (so a +1 is needed)
-		random := model.Move(r.random.Intn(3) + 1)
-		r.move = random
-	default:
-		// The human will most likely copy the computer throw if he/her loses.
-		// Therefore, the computer should play what beats its last throw.
-		// Also, the human will most likely repeat throw if he/her wins.
-		// So the computer should play what beats the human last throw.
+	r.chooseMove()
+	r.nonce = fairness.NewNonce()
+	r.commitment = fairness.Commit(r.move, r.nonce)
+	if r.transcript != nil {
+		// written before the opponent chooses, so the transcript proves the move came first
+		r.round++
+		r.writeTranscript(fairness.NewCommitEntry(r.round, r.name, r.commitment))
+	}
+}
+
+func (r *Computer) writeTranscript(entry fairness.Entry) {
+	if err := fairness.WriteEntry(r.transcript, entry); err != nil {
+		fmt.Printf("cannot write the transcript: %v\n", err)
+	}
+}
+
+// chooseMove picks the move of the strategy, of the opponent model prediction or of the built-in heuristic.
+func (r *Computer) chooseMove() {
+	if r.strategy != nil {
+		r.move = r.strategy.Next()
+		r.reason = fmt.Sprintf("I played %s following my %s strategy", model.MoveToStr[r.move], r.strategy.Name())
+		return
+	}
+	if r.difficulty == model.DifficultyEasy {
+		r.move = model.Move(r.random.Intn(3) + 1)
+		r.reason = fmt.Sprintf("I played %s at random, as the difficulty is easy", model.MoveToStr[r.move])
+		return
+	}
+	if r.opponent != nil {
+		// on hard, a favourite move is enough, without the confidence normal needs
+		if prediction, ok := r.opponent.Predict(); ok || (r.difficulty == model.DifficultyHard && prediction.Move.Valid()) {
+			r.move = model.Counter(prediction.Move)
+			r.reason = fmt.Sprintf("you played %s %.0f%% of the time %s (%d rounds), so I played %s",
+				model.MoveToStr[prediction.Move], 100*prediction.Probability, prediction.Context,
+				prediction.Samples, model.MoveToStr[r.move])
+			return
+		}
+	}
+	if r.trained != nil {
+		r.move = r.trained.Next()
+		r.reason = fmt.Sprintf("I played %s following my trained %s strategy", model.MoveToStr[r.move], r.trained.Name())
+		return
+	}
+	// The human will most likely copy the computer throw if he/her loses.
+	// Therefore, the computer should play what beats its last throw.
+	// Also, the human will most likely repeat throw if he/her wins.
+	// So the computer should play what beats the human last throw.
+	// Both cases lead to the computer playing what was not played yet among the three possible moves,
+	// and after a tie the throw is reset, so it plays at random.
+	r.move = strategies.HeuristicMove(r.random, r.throw.WinnerMove, r.throw.LoserMove)
+	if r.throw.WinnerName == "" {
+		r.reason = fmt.Sprintf("nobody won last round, so I played %s at random", model.MoveToStr[r.move])
+		return
+	}
+	r.reason = r.missingMoveReason()
+}
 
-		// Both cases lead to the computer playing what was not played yet among the three possible moves.
-		r.move = r.getMissingMove()
+// missingMoveReason describes, from the opponent's point of view, the prediction behind the missing move.
+func (r *Computer) missingMoveReason() string {
+	if r.throw.WinnerName == r.name {
+		return fmt.Sprintf("you lost with %s last round, I predicted you'd copy my %s, so I played %s",
+			model.MoveToStr[r.throw.LoserMove],
+			model.MoveToStr[r.throw.WinnerMove],
+			model.MoveToStr[r.move])
 	}
+	return fmt.Sprintf("you won with %s last round, I predicted you'd repeat it, so I played %s",
+		model.MoveToStr[r.throw.WinnerMove],
+		model.MoveToStr[r.move])
+}
+
+// SetDifficulty sets how hard the computer plays when it has no strategy, see model.DifficultyEasy.
+func (r *Computer) SetDifficulty(difficulty string) {
+	r.difficulty = difficulty
+}
+
+// SetStrategy makes the computer play the strategy instead of its built-in heuristic.
+func (r *Computer) SetStrategy(strategy model.Strategy) {
+	r.strategy = strategy
 }
 
-// getMissingMove returns the move that was not played in the throw
-func (r *Computer) getMissingMove() model.Move {
-	return (model.Rock + model.Paper + model.Scissors) - r.throw.WinnerMove - r.throw.LoserMove
+// SetTrained makes the computer play the trained strategy instead of its built-in heuristic, once the
+// difficulty and the opponent model had their say.
+func (r *Computer) SetTrained(strategy model.Strategy) {
+	r.trained = strategy
+}
+
+// SetOpponentModel makes the computer learn the human moves into the model and exploit its predictions.
+func (r *Computer) SetOpponentModel(opponent *opponents.Model) {
+	r.opponent = opponent
+}
+
+// StrategyName returns the name of the strategy, or of the trained one, or heuristic when the computer
+// plays its built-in heuristic.
+func (r *Computer) StrategyName() string {
+	if r.strategy != nil {
+		return r.strategy.Name()
+	}
+	if r.trained != nil {
+		return r.trained.Name()
+	}
+	return "heuristic"
+}
+
+// Commitment returns the SHA-256 commitment of the current move, published before the opponent chooses.
+func (r *Computer) Commitment() string {
+	return r.commitment
+}
+
+// Reveal returns the current move and the nonce of its commitment.
+func (r *Computer) Reveal() (model.Move, string) {
+	return r.move, r.nonce
+}
+
+// SetTranscript makes the computer write every commitment to w before the opponent chooses, and its
+// reveal after the round, to be checked with the verify command.
+func (r *Computer) SetTranscript(w io.Writer) {
+	r.transcript = w
+}
+
+// ObserveRound forwards the round to the strategies and to the opponent model, if any, and writes
+// the reveal of the commitment to the transcript.
+func (r *Computer) ObserveRound(own, opponent model.Move) {
+	if r.transcript != nil && r.commitment != "" {
+		r.writeTranscript(fairness.NewRevealEntry(r.round, r.name, own, r.nonce, opponent))
+	}
+	if r.strategy != nil {
+		r.strategy.Observe(own, opponent)
+	}
+	if r.trained != nil {
+		r.trained.Observe(own, opponent)
+	}
+	if r.opponent != nil {
+		r.opponent.Observe(opponent, own)
+	}
+}
+
+// SetExplain enables or disables the explanation of the computer moves.
+func (r *Computer) SetExplain(enabled bool) {
+	r.explain = enabled
+}
+
+// Explain returns the reasoning behind the current move, or an empty string if explain mode is disabled.
+func (r *Computer) Explain() string {
+	if !r.explain {
+		return ""
+	}
+	return r.reason
 }
 
 func (r *Computer) IncrementScore() {
@@ -71,4 +220,14 @@ func (r *Computer) GetScore() int {
 func (r *Computer) ResetScore() {
 	r.score = 0
 	r.move = 0
+	r.reason = ""
+	if r.strategy != nil {
+		r.strategy.Reset()
+	}
+	if r.trained != nil {
+		r.trained.Reset()
+	}
+	if r.opponent != nil {
+		r.opponent.NewGame()
+	}
 }
@@ -1,14 +1,18 @@
 package players
 
 import (
+	"bytes"
 	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yuripiffer/rock-paper-scissors/fairness"
 	"github.com/yuripiffer/rock-paper-scissors/game"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
 )
 
 func TestComputer_SetName(t *testing.T) {
@@ -152,3 +156,198 @@ func TestComputer_ResetScore(t *testing.T) {
 	assert.Zero(t, c.score)
 	assert.Zero(t, c.move)
 }
+
+func TestComputer_Explain(t *testing.T) {
+	tests := []struct {
+		name    string
+		explain bool
+		throw   *game.Throw
+		want    string
+	}{
+		{
+			name:    "explain mode disabled",
+			explain: false,
+			throw:   &game.Throw{WinnerName: ""},
+			want:    "",
+		},
+		{
+			name:    "no winner in previous round, random move",
+			explain: true,
+			throw:   &game.Throw{WinnerName: ""},
+			want:    "nobody won last round, so I played Paper at random",
+		},
+		{
+			name:    "computer won the previous round",
+			explain: true,
+			throw: &game.Throw{
+				WinnerName: computerName,
+				WinnerMove: model.Paper,
+				LoserMove:  model.Rock},
+			want: "you lost with Rock last round, I predicted you'd copy my Paper, so I played Scissors",
+		},
+		{
+			name:    "computer lost the previous round",
+			explain: true,
+			throw: &game.Throw{
+				WinnerName: "ALICE",
+				WinnerMove: model.Rock,
+				LoserMove:  model.Scissors},
+			want: "you won with Rock last round, I predicted you'd repeat it, so I played Paper",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Computer{
+				name:   computerName,
+				random: &model.RandomizerMock{IntnFunc: func(n int) int { return 1 }},
+				throw:  tt.throw,
+			}
+			c.SetExplain(tt.explain)
+			c.SetNextMove()
+			assert.Equal(t, tt.want, c.Explain())
+		})
+	}
+}
+
+func TestComputer_SetStrategy(t *testing.T) {
+	c := InitComputerPlayer(&game.Throw{}, &model.RandomizerMock{})
+	assert.Equal(t, "heuristic", c.StrategyName())
+	c.SetStrategy(strategies.NewCopycat(&model.RandomizerMock{IntnFunc: func(n int) int { return 0 }}))
+	assert.Equal(t, "copycat", c.StrategyName())
+	c.SetExplain(true)
+
+	c.SetNextMove()
+	assert.Equal(t, model.Rock, c.GetMove())
+	assert.Equal(t, "I played Rock following my copycat strategy", c.Explain())
+
+	c.ObserveRound(model.Rock, model.Scissors)
+	c.SetNextMove()
+	assert.Equal(t, model.Scissors, c.GetMove(), "the strategy observes the rounds")
+
+	c.ResetScore()
+	c.SetNextMove()
+	assert.Equal(t, model.Rock, c.GetMove(), "the strategy is reset with the score")
+}
+
+func TestComputer_SetTrained(t *testing.T) {
+	trained := strategies.NewConstant("paper", model.Paper)
+	c := InitComputerPlayer(&game.Throw{}, &model.RandomizerMock{IntnFunc: func(n int) int { return 0 }})
+	c.SetExplain(true)
+	c.SetTrained(trained)
+	assert.Equal(t, "paper", c.StrategyName())
+
+	c.SetNextMove()
+	assert.Equal(t, model.Paper, c.GetMove(), "the trained strategy replaces the heuristic")
+	assert.Equal(t, "I played Paper following my trained paper strategy", c.Explain())
+
+	c.SetDifficulty(model.DifficultyEasy)
+	c.SetNextMove()
+	assert.Equal(t, model.Rock, c.GetMove(), "the difficulty comes first")
+
+	c.SetDifficulty(model.DifficultyNormal)
+	profile := opponents.NewModel("ANA")
+	for i := 0; i < 5; i++ {
+		profile.Observe(model.Scissors, model.Rock)
+		profile.NewGame()
+	}
+	c.SetOpponentModel(profile)
+	c.SetNextMove()
+	assert.Equal(t, model.Rock, c.GetMove(), "the opponent model comes first")
+}
+
+func TestComputer_heuristicStrategy(t *testing.T) {
+	// the computer strategy of the headless commands plays like the computer of the game
+	throw := &game.Throw{}
+	c := InitComputerPlayer(throw, rand.New(rand.NewSource(7)))
+	heuristic := strategies.NewHeuristic(rand.New(rand.NewSource(7)))
+	humanMoves := rand.New(rand.NewSource(3))
+	for i := 0; i < 50; i++ {
+		c.SetNextMove()
+		assert.Equal(t, heuristic.Next(), c.GetMove(), "round %d", i)
+
+		human := model.Moves[humanMoves.Intn(3)]
+		heuristic.Observe(c.GetMove(), human)
+		switch {
+		case model.Beats(c.GetMove(), human):
+			*throw = game.Throw{WinnerMove: c.GetMove(), LoserMove: human, WinnerName: c.GetName()}
+		case model.Beats(human, c.GetMove()):
+			*throw = game.Throw{WinnerMove: human, LoserMove: c.GetMove(), WinnerName: "ANA"}
+		default:
+			*throw = game.Throw{}
+		}
+	}
+}
+
+func TestComputer_SetOpponentModel(t *testing.T) {
+	c := InitComputerPlayer(&game.Throw{}, &model.RandomizerMock{IntnFunc: func(n int) int { return 0 }})
+	c.SetExplain(true)
+	c.SetOpponentModel(opponents.NewModel("ANA"))
+
+	c.SetNextMove()
+	assert.Equal(t, model.Rock, c.GetMove(), "falls back to the heuristic without predictions")
+
+	for i := 0; i < 5; i++ {
+		c.ObserveRound(model.Rock, model.Scissors)
+		c.ResetScore()
+	}
+	c.SetNextMove()
+	assert.Equal(t, model.Rock, c.GetMove(), "rock beats the scissors the human always plays")
+	assert.Equal(t, "you played Scissors 100% of the time overall (5 rounds), so I played Rock", c.Explain())
+}
+
+func TestComputer_Commitment(t *testing.T) {
+	c := InitComputerPlayer(&game.Throw{}, &model.RandomizerMock{IntnFunc: func(n int) int { return 1 }})
+	c.SetName()
+	transcript := &bytes.Buffer{}
+	c.SetTranscript(transcript)
+
+	c.SetNextMove()
+	move, nonce := c.Reveal()
+	assert.Equal(t, model.Paper, move)
+	assert.True(t, fairness.Verify(c.Commitment(), move, nonce))
+	commit := fairness.NewCommitEntry(1, "ROBOT", c.Commitment())
+	entries, err := fairness.ReadTranscript(bytes.NewReader(transcript.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, []fairness.Entry{commit}, entries, "the commitment is written before the opponent chooses")
+
+	c.ObserveRound(move, model.Rock)
+	entries, err = fairness.ReadTranscript(transcript)
+	assert.NoError(t, err)
+	assert.Equal(t, []fairness.Entry{commit, fairness.NewRevealEntry(1, "ROBOT", model.Paper, nonce, model.Rock)}, entries)
+
+	c.SetNextMove()
+	_, next := c.Reveal()
+	assert.NotEqual(t, nonce, next, "every move gets a new nonce")
+}
+
+func TestComputer_SetDifficulty(t *testing.T) {
+	tests := []struct {
+		difficulty string
+		wantMove   model.Move
+		wantReason string
+	}{
+		{model.DifficultyEasy, model.Scissors, "I played Scissors at random, as the difficulty is easy"},
+		{model.DifficultyNormal, model.Scissors, "nobody won last round, so I played Scissors at random"},
+		{model.DifficultyHard, model.Paper, "you played Rock 40% of the time after Rock against Rock (5 rounds), so I played Paper"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.difficulty, func(t *testing.T) {
+			// after a draw with rock, the human played rock and paper twice and scissors once
+			profile := opponents.NewModel("ANA")
+			for _, move := range []model.Move{model.Rock, model.Rock, model.Paper, model.Paper, model.Scissors} {
+				profile.Observe(model.Rock, model.Rock)
+				profile.Observe(move, model.Rock)
+				profile.NewGame()
+			}
+			c := InitComputerPlayer(&game.Throw{}, &model.RandomizerMock{IntnFunc: func(n int) int { return 2 }})
+			c.SetExplain(true)
+			c.SetDifficulty(tt.difficulty)
+			c.SetOpponentModel(profile)
+			c.ObserveRound(model.Rock, model.Rock)
+
+			c.SetNextMove()
+			assert.Equal(t, tt.wantMove, c.GetMove())
+			assert.Equal(t, tt.wantReason, c.Explain())
+		})
+	}
+}
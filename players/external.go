@@ -0,0 +1,153 @@
+package players
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/botproto"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+const externalName string = "BOT"
+
+// External is the implementation of Player for bots running as a child process.
+// A bot that crashes, times out or answers with anything but a legal move forfeits:
+// from then on it plays no move and loses every round.
+type External struct {
+	name     string
+	botName  string
+	conn     *botproto.Conn
+	timeout  time.Duration
+	move     model.Move
+	score    int
+	round    int
+	history  []botproto.Round
+	forfeit  error
+	messages io.Writer
+}
+
+// InitExternalPlayer launches the bot command and completes the protocol handshake.
+// Forfeit notices are written to messages.
+func InitExternalPlayer(command []string, timeout time.Duration, messages io.Writer) (*External, error) {
+	conn, err := botproto.Start(command, nil)
+	if err != nil {
+		return nil, err
+	}
+	botName, err := conn.Handshake(timeout)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("bot handshake: %w", err)
+	}
+
+	e := External{
+		botName:  botName,
+		conn:     conn,
+		timeout:  timeout,
+		messages: messages,
+	}
+	e.SetName()
+	return &e, nil
+}
+
+func (r *External) SetName() {
+	r.name = strings.ToUpper(strings.TrimSpace(r.botName))
+	if r.name == "" {
+		r.name = externalName
+	}
+}
+
+func (r *External) GetName() string {
+	return r.name
+}
+
+// StrategyName returns "bot:" followed by the name the bot gave in the handshake, or "bot" when it
+// gave none, as the strategy of an external bot is unknown.
+func (r *External) StrategyName() string {
+	if strings.TrimSpace(r.botName) == "" {
+		return "bot"
+	}
+	return "bot:" + r.name
+}
+
+func (r *External) GetMove() model.Move {
+	return r.move
+}
+
+func (r *External) SetNextMove() {
+	r.move = 0
+	if r.forfeit != nil {
+		return
+	}
+	r.round++
+	move, err := r.conn.RequestMove(r.round, r.history, r.timeout)
+	if err != nil {
+		r.forfeits(err)
+		return
+	}
+	r.move = move
+}
+
+// ObserveRound sends the result of the round to the bot.
+func (r *External) ObserveRound(own, opponent model.Move) {
+	if r.forfeit != nil {
+		return
+	}
+	played := botproto.NewRound(own, opponent)
+	r.history = append(r.history, played)
+	err := r.conn.Send(botproto.Message{
+		Type:     botproto.TypeResult,
+		Round:    r.round,
+		You:      played.You,
+		Opponent: played.Opponent,
+		Outcome:  played.Outcome,
+	})
+	if err != nil {
+		r.forfeits(err)
+	}
+}
+
+// Forfeit returns why the bot forfeited, or nil while it plays by the rules.
+func (r *External) Forfeit() error {
+	return r.forfeit
+}
+
+// Close ends the bot process.
+func (r *External) Close() error {
+	return r.conn.Close()
+}
+
+func (r *External) forfeits(err error) {
+	r.forfeit = err
+	if r.messages != nil {
+		_, _ = fmt.Fprintf(r.messages, "%s forfeits: %v\n", r.name, err)
+	}
+	_ = r.conn.Close()
+}
+
+func (r *External) IncrementScore() {
+	r.score += 1
+}
+
+func (r *External) GetScore() int {
+	return r.score
+}
+
+// ResetScore starts a new game, which the bot must acknowledge.
+func (r *External) ResetScore() {
+	r.score = 0
+	r.move = 0
+	r.round = 0
+	r.history = nil
+	if r.forfeit != nil {
+		return
+	}
+	if err := r.conn.Send(botproto.Message{Type: botproto.TypeNewGame}); err != nil {
+		r.forfeits(err)
+		return
+	}
+	if _, err := r.conn.Expect(botproto.TypeReady, r.timeout); err != nil {
+		r.forfeits(err)
+	}
+}
@@ -0,0 +1,142 @@
+package players
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/botproto"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+const helperBotEnv = "RPS_TEST_EXTERNAL_BOT"
+
+// TestHelperExternalBot is not a real test: it is the bot process launched by the External tests.
+func TestHelperExternalBot(t *testing.T) {
+	behaviour := os.Getenv(helperBotEnv)
+	if behaviour == "" {
+		return
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+	for scanner.Scan() {
+		msg := botproto.Message{}
+		_ = json.Unmarshal(scanner.Bytes(), &msg)
+		switch msg.Type {
+		case botproto.TypeHello, botproto.TypeNewGame:
+			_ = encoder.Encode(botproto.Message{Type: botproto.TypeReady, Name: "copy cat"})
+		case botproto.TypePlay:
+			switch {
+			case behaviour == "crash":
+				os.Exit(2)
+			case behaviour == "slow":
+				time.Sleep(5 * time.Second)
+			case behaviour == "illegal":
+				_ = encoder.Encode(botproto.Message{Type: botproto.TypeMove, Move: "lizard"})
+			case len(msg.History) == 0:
+				_ = encoder.Encode(botproto.Message{Type: botproto.TypeMove, Move: "rock"})
+			default:
+				last := msg.History[len(msg.History)-1]
+				_ = encoder.Encode(botproto.Message{Type: botproto.TypeMove, Move: last.Opponent})
+			}
+		case botproto.TypeQuit:
+			os.Exit(0)
+		}
+	}
+	os.Exit(0)
+}
+
+func initHelperExternalPlayer(t *testing.T, behaviour string, messages *bytes.Buffer) *External {
+	t.Setenv(helperBotEnv, behaviour)
+	e, err := InitExternalPlayer([]string{os.Args[0], "-test.run=^TestHelperExternalBot$"}, 500*time.Millisecond, messages)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = e.Close() })
+	return e
+}
+
+func TestInitExternalPlayer(t *testing.T) {
+	e := initHelperExternalPlayer(t, "copycat", nil)
+	assert.Equal(t, "COPY CAT", e.GetName())
+	assert.Equal(t, "bot:COPY CAT", e.StrategyName(), "bots are told apart by their handshake name")
+
+	unnamed := &External{}
+	unnamed.SetName()
+	assert.Equal(t, "BOT", unnamed.GetName())
+	assert.Equal(t, "bot", unnamed.StrategyName())
+}
+
+func TestExternal_SetNextMove(t *testing.T) {
+	tests := []struct {
+		name        string
+		behaviour   string
+		wantMoves   []model.Move
+		wantForfeit bool
+	}{
+		{
+			name:      "bot copies the opponent's last move",
+			behaviour: "copycat",
+			wantMoves: []model.Move{model.Rock, model.Scissors, model.Paper},
+		},
+		{
+			name:        "bot crashes and forfeits",
+			behaviour:   "crash",
+			wantMoves:   []model.Move{0, 0, 0},
+			wantForfeit: true,
+		},
+		{
+			name:        "bot times out and forfeits",
+			behaviour:   "slow",
+			wantMoves:   []model.Move{0, 0, 0},
+			wantForfeit: true,
+		},
+		{
+			name:        "bot plays an illegal move and forfeits",
+			behaviour:   "illegal",
+			wantMoves:   []model.Move{0, 0, 0},
+			wantForfeit: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := &bytes.Buffer{}
+			e := initHelperExternalPlayer(t, tt.behaviour, messages)
+			opponentMoves := []model.Move{model.Scissors, model.Paper, model.Rock}
+			for i, want := range tt.wantMoves {
+				e.SetNextMove()
+				assert.Equal(t, want, e.GetMove())
+				e.ObserveRound(e.GetMove(), opponentMoves[i])
+			}
+			assert.Equal(t, tt.wantForfeit, e.Forfeit() != nil)
+			if tt.wantForfeit {
+				assert.Contains(t, messages.String(), "COPY CAT forfeits")
+			}
+		})
+	}
+}
+
+func TestExternal_ResetScore(t *testing.T) {
+	e := initHelperExternalPlayer(t, "copycat", nil)
+	e.SetNextMove()
+	e.ObserveRound(e.GetMove(), model.Paper)
+	e.IncrementScore()
+
+	e.ResetScore()
+	assert.Zero(t, e.GetScore())
+	assert.Zero(t, e.GetMove())
+	assert.NoError(t, e.Forfeit())
+
+	// the history starts over, so the bot opens with rock again
+	e.SetNextMove()
+	assert.Equal(t, model.Rock, e.GetMove())
+}
+
+func TestExternal_IncrementScore(t *testing.T) {
+	e := &External{score: 2}
+	e.IncrementScore()
+	assert.Equal(t, 3, e.GetScore())
+}
@@ -1,12 +1,15 @@
 package players
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"strings"
 	"time"
 
 	"github.com/yuripiffer/rock-paper-scissors/cli"
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/profiles"
 )
 
 // Human is the implementation of Player to represent the user.
@@ -15,6 +18,9 @@ type Human struct {
 	cliInput model.InputWatcher
 	move     model.Move
 	score    int
+	// profiles offers the known players in SetName, profile is the one of this player.
+	profiles *profiles.Store
+	profile  *profiles.Profile
 }
 
 func InitHumanPlayer(cliInput model.InputWatcher) *Human {
@@ -31,11 +37,30 @@ func (r *Human) GetMove() model.Move {
 	return r.move
 }
 
+// SetProfiles makes SetName offer the profiles of the store and create a profile for new players.
+func (r *Human) SetProfiles(store *profiles.Store) {
+	r.profiles = store
+}
+
+// Profile returns the profile of the player, nil without a profile store.
+func (r *Human) Profile() *profiles.Profile {
+	return r.profile
+}
+
 func (r *Human) SetName() {
+	if r.profiles != nil {
+		r.selectProfile()
+		return
+	}
+	r.enterName()
+}
+
+// enterName asks the name of the player, in free text, and returns it as typed.
+func (r *Human) enterName() string {
 	for {
 		input, err := r.cliInput.Text("Enter your name: ")
 		if input == "0" && err == nil {
-			return
+			return ""
 		}
 		if err != nil {
 			fmt.Print("Invalid input. Let's try again...")
@@ -46,8 +71,65 @@ func (r *Human) SetName() {
 		}
 		r.name = strings.ToUpper(input)
 		cli.MoveCursorUpLeft()
+		return input
+	}
+}
+
+// selectProfile offers the known profiles and the creation of a new one.
+func (r *Human) selectProfile() {
+	known, err := r.profiles.List()
+	if err != nil {
+		fmt.Printf("cannot list the profiles: %v\n", err)
+	}
+	for len(known) > 0 {
+		fmt.Println("Profiles:")
+		for i, profile := range known {
+			fmt.Printf("  %d. %s (%d games)\n", i+1, profile.DisplayName, profile.Stats.Games)
+		}
+		choice, err := r.cliInput.Number(fmt.Sprintf("Choose your profile, %d for a new one or %v to exit: ", len(known)+1, model.Exit))
+		if choice == 0 && err == nil {
+			return
+		}
+		if err != nil || choice < 1 || choice > len(known)+1 {
+			fmt.Print("Invalid input. Let's try again...")
+			time.Sleep(model.Span.Time1s)
+			cli.MoveCursorUpLeft()
+			continue
+		}
+		cli.MoveCursorUpLeft()
+		if choice <= len(known) {
+			r.useProfile(known[choice-1])
+			return
+		}
 		break
 	}
+
+	displayName := r.enterName()
+	if displayName == "" {
+		return
+	}
+	profile, err := r.profiles.Load(displayName)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// a new player
+		profile = profiles.New(displayName)
+	case err != nil:
+		// the profile is left as is instead of being replaced by a new one
+		fmt.Printf("cannot load the profile: %v\n", err)
+		r.name = ""
+		return
+	}
+	r.useProfile(profile)
+}
+
+// useProfile makes the player the one of the profile, and saves the profile as last played now.
+func (r *Human) useProfile(profile *profiles.Profile) {
+	profile.LastPlayed = time.Now()
+	if err := r.profiles.Save(profile); err != nil {
+		fmt.Printf("cannot save the profile: %v\n", err)
+	}
+	r.profile = profile
+	r.name = profile.Name
 }
 
 func (r *Human) SetNextMove() {
@@ -58,7 +140,7 @@ func (r *Human) SetNextMove() {
 			return
 		}
 		if err != nil || choice < 1 || choice > 3 {
-			cli.MoveCursorUpLeft()
+			// the screen is not cleared, so the commitment of the opponent stays in sight
 			fmt.Println("Invalid input. Please enter 1, 2, or 3:")
 			continue
 		}
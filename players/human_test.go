@@ -2,11 +2,16 @@ package players
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/profiles"
+	"github.com/yuripiffer/rock-paper-scissors/testutils"
 )
 
 func TestHuman_GetName(t *testing.T) {
@@ -96,6 +101,125 @@ func TestHuman_SetName(t *testing.T) {
 	}
 }
 
+func TestHuman_SetName_profiles(t *testing.T) {
+	restoreTimeSpan := testutils.IgnoreSleep()
+	defer restoreTimeSpan()
+
+	tests := []struct {
+		name        string
+		known       []string
+		numbers     []int
+		texts       []string
+		wantName    string
+		wantDisplay string
+		wantGames   int
+	}{
+		{
+			name:        "chooses a known profile",
+			known:       []string{"Ana", "Paul"},
+			numbers:     []int{2},
+			wantName:    "PAUL",
+			wantDisplay: "Paul",
+		},
+		{
+			name:        "invalid choice, then a known profile",
+			known:       []string{"Ana", "Paul"},
+			numbers:     []int{5, 1},
+			wantName:    "ANA",
+			wantDisplay: "Ana",
+			wantGames:   3,
+		},
+		{
+			name:        "creates a new profile",
+			known:       []string{"Ana", "Paul"},
+			numbers:     []int{3},
+			texts:       []string{"Zoe"},
+			wantName:    "ZOE",
+			wantDisplay: "Zoe",
+		},
+		{
+			name:        "types the name of a known profile",
+			known:       []string{"Ana"},
+			numbers:     []int{2},
+			texts:       []string{"ana"},
+			wantName:    "ANA",
+			wantDisplay: "Ana",
+			wantGames:   3,
+		},
+		{
+			name:        "first player",
+			texts:       []string{"Bob"},
+			wantName:    "BOB",
+			wantDisplay: "Bob",
+		},
+		{
+			name:    "exits",
+			known:   []string{"Ana"},
+			numbers: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := profiles.NewStore(t.TempDir())
+			for _, name := range tt.known {
+				profile := profiles.New(name)
+				if name == "Ana" {
+					profile.Stats.Games = 3
+				}
+				assert.NoError(t, store.Save(profile))
+			}
+			mockInput := &model.InputWatcherMock{
+				NumberFunc: func(message string) (int, error) {
+					n := tt.numbers[0]
+					tt.numbers = tt.numbers[1:]
+					return n, nil
+				},
+				TextFunc: func(message string) (string, error) {
+					text := tt.texts[0]
+					tt.texts = tt.texts[1:]
+					return text, nil
+				},
+			}
+			h := InitHumanPlayer(mockInput)
+			h.SetProfiles(store)
+			_, err := testutils.CaptureStdout(h.SetName)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantName, h.GetName())
+			assert.Empty(t, tt.numbers)
+			assert.Empty(t, tt.texts)
+			if tt.wantName == "" {
+				assert.Nil(t, h.Profile())
+				return
+			}
+			assert.Equal(t, tt.wantDisplay, h.Profile().DisplayName)
+			saved, err := store.Load(tt.wantName)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantGames, saved.Stats.Games)
+			assert.False(t, saved.LastPlayed.IsZero(), "the profile is saved as last played")
+		})
+	}
+}
+
+func TestHuman_SetName_unreadableProfile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ZOE.json")
+	assert.NoError(t, os.WriteFile(path, []byte("{not json"), 0o644))
+	h := InitHumanPlayer(&model.InputWatcherMock{
+		TextFunc: func(message string) (string, error) { return "Zoe", nil },
+	})
+	h.SetProfiles(profiles.NewStore(dir))
+
+	out, err := testutils.CaptureStdout(h.SetName)
+	assert.NoError(t, err)
+	assert.Contains(t, out, "cannot load the profile: ")
+	assert.Empty(t, h.GetName(), "the game does not start")
+	assert.Nil(t, h.Profile())
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "{not json", string(data), "the profile is not replaced")
+}
+
 func TestHuman_SetNextMove(t *testing.T) {
 
 	// response from cli.Input method
@@ -154,6 +278,24 @@ func TestHuman_SetNextMove(t *testing.T) {
 	}
 }
 
+func TestHuman_SetNextMove_invalidInput(t *testing.T) {
+	inputs := []int{7, 2}
+	h := &Human{cliInput: &model.InputWatcherMock{
+		NumberFunc: func(msg string) (int, error) {
+			n := inputs[0]
+			inputs = inputs[1:]
+			return n, nil
+		},
+	}}
+	out, err := testutils.CaptureStdout(h.SetNextMove)
+	assert.NoError(t, err)
+	assert.Equal(t, model.Paper, h.move)
+	assert.Contains(t, out, "Invalid input. Please enter 1, 2, or 3:")
+	// the commitment printed before the prompt is only cleared once the move is chosen
+	assert.Equal(t, 1, strings.Count(out, "\033[2J"))
+	assert.True(t, strings.HasSuffix(out, "\033[2J\033[H"))
+}
+
 func TestHuman_IncrementScore(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,71 @@
+package players
+
+import (
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Replay is the implementation of Player that plays the moves of one side of a recorded match again.
+type Replay struct {
+	name  string
+	moves []model.Move
+	// won tells which rounds the side won.
+	won   []bool
+	round int
+	move  model.Move
+	score int
+}
+
+func InitReplayPlayer(match model.Match, side int) *Replay {
+	r := Replay{name: match.Players[side]}
+	for _, round := range match.Rounds {
+		r.moves = append(r.moves, round.Moves[side])
+		r.won = append(r.won, round.Winner != "" && round.Winner == r.name)
+	}
+	return &r
+}
+
+// SetName keeps the recorded name.
+func (r *Replay) SetName() {}
+
+func (r *Replay) GetName() string {
+	return r.name
+}
+
+func (r *Replay) GetMove() model.Move {
+	return r.move
+}
+
+// SetNextMove plays the move of the next recorded round, or repeats the last one after the end.
+func (r *Replay) SetNextMove() {
+	if r.round < len(r.moves) {
+		r.move = r.moves[r.round]
+		r.round++
+	}
+}
+
+// Seek goes back or forward to the round, as if the rounds before it had just been played.
+func (r *Replay) Seek(round int) {
+	r.round = min(max(round, 0), len(r.moves))
+	r.move, r.score = 0, 0
+	if r.round > 0 {
+		r.move = r.moves[r.round-1]
+	}
+	for _, won := range r.won[:r.round] {
+		if won {
+			r.score++
+		}
+	}
+}
+
+func (r *Replay) IncrementScore() {
+	r.score += 1
+}
+
+func (r *Replay) GetScore() int {
+	return r.score
+}
+
+// ResetScore starts the replay over.
+func (r *Replay) ResetScore() {
+	r.Seek(0)
+}
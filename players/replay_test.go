@@ -0,0 +1,73 @@
+package players
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// replayedMatch is a match ANA won 2-1 after a draw.
+var replayedMatch = model.Match{
+	Players: [2]string{"ANA", "ROBOT"},
+	Winner:  "ANA",
+	Rounds: []model.MatchRound{
+		{Moves: [2]model.Move{model.Rock, model.Rock}},
+		{Moves: [2]model.Move{model.Rock, model.Paper}, Winner: "ROBOT"},
+		{Moves: [2]model.Move{model.Scissors, model.Paper}, Winner: "ANA"},
+		{Moves: [2]model.Move{model.Paper, model.Rock}, Winner: "ANA"},
+	},
+}
+
+func TestReplay_SetNextMove(t *testing.T) {
+	r := InitReplayPlayer(replayedMatch, 1)
+	r.SetName()
+	assert.Equal(t, "ROBOT", r.GetName())
+
+	var moves []model.Move
+	for range 5 {
+		r.SetNextMove()
+		moves = append(moves, r.GetMove())
+	}
+	assert.Equal(t, []model.Move{model.Rock, model.Paper, model.Paper, model.Rock, model.Rock}, moves,
+		"the last move is repeated after the end")
+}
+
+func TestReplay_Seek(t *testing.T) {
+	tests := []struct {
+		name      string
+		round     int
+		wantMove  model.Move
+		wantScore int
+		wantNext  model.Move
+	}{
+		{"start", 0, 0, 0, model.Rock},
+		{"after the draw", 1, model.Rock, 0, model.Rock},
+		{"after two wins", 4, model.Paper, 2, model.Paper},
+		{"before the start", -1, 0, 0, model.Rock},
+		{"after the end", 10, model.Paper, 2, model.Paper},
+		{"after the second win", 3, model.Scissors, 1, model.Paper},
+	}
+	r := InitReplayPlayer(replayedMatch, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.Seek(tt.round)
+			assert.Equal(t, tt.wantMove, r.GetMove())
+			assert.Equal(t, tt.wantScore, r.GetScore())
+			r.SetNextMove()
+			assert.Equal(t, tt.wantNext, r.GetMove())
+		})
+	}
+}
+
+func TestReplay_ResetScore(t *testing.T) {
+	r := InitReplayPlayer(replayedMatch, 0)
+	r.SetNextMove()
+	r.IncrementScore()
+	assert.Equal(t, 1, r.GetScore())
+
+	r.ResetScore()
+	assert.Zero(t, r.GetScore())
+	assert.Zero(t, r.GetMove())
+}
@@ -0,0 +1,90 @@
+// Package profiles keeps the local profiles of the human players: their name, preferences and stats.
+package profiles
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// ProfileVersion is the version of the profile file format.
+const ProfileVersion = 1
+
+// DefaultTheme is the theme of new profiles.
+const DefaultTheme = "red"
+
+// Profile is a local player. Name identifies the player in the history, the ratings and the learned
+// models, DisplayName is the name as typed when the profile was created.
+type Profile struct {
+	Version     int       `json:"version"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	Ruleset     string    `json:"ruleset"`
+	Difficulty  string    `json:"difficulty"`
+	Theme       string    `json:"theme"`
+	Stats       Stats     `json:"stats"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastPlayed  time.Time `json:"last_played,omitempty"`
+}
+
+// Stats summarize the games of the player, as of the end of their last session.
+type Stats struct {
+	Games     int `json:"games"`
+	Wins      int `json:"wins"`
+	Rounds    int `json:"rounds"`
+	RoundWins int `json:"round_wins"`
+}
+
+// New creates the profile of a player with the default preferences.
+func New(displayName string) *Profile {
+	displayName = strings.TrimSpace(displayName)
+	return &Profile{
+		Version:     ProfileVersion,
+		Name:        Name(displayName),
+		DisplayName: displayName,
+		Ruleset:     model.RulesetClassic,
+		Difficulty:  model.DifficultyNormal,
+		Theme:       DefaultTheme,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Name returns the identity of the player with the display name: the name in upper case.
+func Name(displayName string) string {
+	return strings.ToUpper(strings.TrimSpace(displayName))
+}
+
+// Set changes a preference of the profile: display_name, ruleset, difficulty or theme. Themes are
+// checked by the caller, which knows the themes of the terminal.
+func (r *Profile) Set(key, value string) error {
+	value = strings.TrimSpace(value)
+	switch key {
+	case "display_name":
+		if Name(value) != r.Name {
+			return fmt.Errorf("the display name of %s must stay %s, in any case", r.Name, r.Name)
+		}
+		r.DisplayName = value
+	case "ruleset":
+		if value != model.RulesetClassic {
+			return fmt.Errorf("unknown ruleset %q, the only ruleset is %s", value, model.RulesetClassic)
+		}
+		r.Ruleset = value
+	case "difficulty":
+		if !slices.Contains(model.Difficulties, value) {
+			return fmt.Errorf("unknown difficulty %q, want one of %s", value, strings.Join(model.Difficulties, ", "))
+		}
+		r.Difficulty = value
+	case "theme":
+		if value == "" {
+			return errors.New("the theme cannot be empty")
+		}
+		r.Theme = value
+	default:
+		return fmt.Errorf("unknown setting %q, want display_name, ruleset, difficulty or theme", key)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package profiles
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestNew(t *testing.T) {
+	profile := New(" Ana ")
+	assert.Equal(t, "ANA", profile.Name)
+	assert.Equal(t, "Ana", profile.DisplayName)
+	assert.Equal(t, model.RulesetClassic, profile.Ruleset)
+	assert.Equal(t, model.DifficultyNormal, profile.Difficulty)
+	assert.Equal(t, DefaultTheme, profile.Theme)
+}
+
+func TestProfile_Set(t *testing.T) {
+	tests := []struct {
+		key, value string
+		wantErr    bool
+	}{
+		{key: "display_name", value: "ANA"},
+		{key: "display_name", value: "Paul", wantErr: true},
+		{key: "ruleset", value: model.RulesetClassic},
+		{key: "ruleset", value: "lizard-spock", wantErr: true},
+		{key: "difficulty", value: model.DifficultyHard},
+		{key: "difficulty", value: "impossible", wantErr: true},
+		{key: "theme", value: "blue"},
+		{key: "theme", value: "", wantErr: true},
+		{key: "color", value: "blue", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			profile := New("Ana")
+			err := profile.Set(tt.key, tt.value)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+		})
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "profiles"))
+	list, err := store.List()
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	paul, ana := New("Paul"), New("Ana")
+	ana.Stats = Stats{Games: 2, Wins: 1, Rounds: 5, RoundWins: 3}
+	assert.NoError(t, store.Save(paul))
+	assert.NoError(t, store.Save(ana))
+
+	loaded, err := store.Load("ana")
+	assert.NoError(t, err)
+	assert.Equal(t, ana.Stats, loaded.Stats)
+	assert.True(t, ana.CreatedAt.Equal(loaded.CreatedAt))
+
+	list, err = store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ANA", "PAUL"}, []string{list[0].Name, list[1].Name})
+
+	assert.NoError(t, store.Delete("Paul"))
+	assert.Error(t, store.Delete("Paul"))
+	_, err = store.Load("Paul")
+	assert.EqualError(t, err, "no profile for PAUL: file does not exist")
+	assert.ErrorIs(t, err, fs.ErrNotExist, "a missing profile is told apart from an unreadable one")
+}
+
+func TestStore_similarNames(t *testing.T) {
+	store := NewStore(t.TempDir())
+	assert.NoError(t, store.Save(New("Ana!")))
+	assert.NoError(t, store.Save(New("Ana?")))
+
+	for _, name := range []string{"ANA!", "ANA?"} {
+		profile, err := store.Load(name)
+		assert.NoError(t, err)
+		assert.Equal(t, name, profile.Name, "names sanitized alike do not share a profile")
+	}
+	list, err := store.List()
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+}
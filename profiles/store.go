@@ -0,0 +1,98 @@
+package profiles
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// dirName is the directory of the profiles, inside the data directory.
+const dirName = "profiles"
+
+// Store keeps the profiles as JSON files of a directory.
+type Store struct {
+	dir string
+}
+
+// NewStore creates the store of the profiles in dir.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Open creates the store of the profiles of the data directory.
+func Open() (*Store, error) {
+	dir, err := storage.Path(dirName)
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(dir), nil
+}
+
+// path returns the file of the named player, see storage.NamedPath.
+func (r *Store) path(name string) (string, error) {
+	return storage.NamedPath(r.dir, name)
+}
+
+// Load reads the profile of the named player.
+func (r *Store) Load(name string) (*Profile, error) {
+	path, err := r.path(Name(name))
+	if err != nil {
+		return nil, err
+	}
+	profile := &Profile{}
+	err = storage.LoadJSON(path, profile)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("no profile for %s: %w", Name(name), fs.ErrNotExist)
+	case err != nil:
+		return nil, fmt.Errorf("%s: %w", path, err)
+	case profile.Version != ProfileVersion:
+		return nil, fmt.Errorf("%s: unsupported profile version %d, want %d", path, profile.Version, ProfileVersion)
+	}
+	return profile, nil
+}
+
+// Save writes the profile.
+func (r *Store) Save(profile *Profile) error {
+	path, err := r.path(profile.Name)
+	if err != nil {
+		return err
+	}
+	return storage.SaveJSON(path, profile)
+}
+
+// Delete removes the profile of the named player.
+func (r *Store) Delete(name string) error {
+	path, err := r.path(Name(name))
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("no profile for %s: %w", Name(name), fs.ErrNotExist)
+	}
+	return err
+}
+
+// List returns the profiles, sorted by name.
+func (r *Store) List() ([]*Profile, error) {
+	paths, err := filepath.Glob(filepath.Join(r.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*Profile, 0, len(paths))
+	for _, path := range paths {
+		profile := &Profile{}
+		if err = storage.LoadJSON(path, profile); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		profiles = append(profiles, profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, nil
+}
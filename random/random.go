@@ -0,0 +1,45 @@
+// Package random provides the sources of randomness behind model.Randomizer.
+package random
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+// Generator adapts a math/rand/v2 generator to model.Randomizer.
+type Generator struct {
+	rand *rand.Rand
+}
+
+func (r *Generator) Intn(n int) int {
+	return r.rand.IntN(n)
+}
+
+// NewPCG creates a fast, seeded randomizer backed by the PCG generator; the same seed and stream
+// always produce the same numbers.
+func NewPCG(seed, stream uint64) *Generator {
+	return &Generator{rand: rand.New(rand.NewPCG(seed, stream))}
+}
+
+// NewCrypto creates a randomizer reading the operating system's secure random number generator.
+func NewCrypto() *Generator {
+	return &Generator{rand: rand.New(cryptoSource{})}
+}
+
+// NewChaCha8 creates a seeded randomizer backed by the ChaCha8 generator.
+func NewChaCha8(seed int64) *Generator {
+	key := [32]byte{}
+	binary.LittleEndian.PutUint64(key[:], uint64(seed))
+	return &Generator{rand: rand.New(rand.NewChaCha8(key))}
+}
+
+// cryptoSource is a math/rand/v2 source reading crypto/rand.
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	b := [8]byte{}
+	// crypto/rand.Read never returns an error, it crashes the program when the system source fails
+	_, _ = crand.Read(b[:])
+	return binary.LittleEndian.Uint64(b[:])
+}
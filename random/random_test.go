@@ -0,0 +1,47 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPCG(t *testing.T) {
+	draw := func(r *Generator) []int {
+		numbers := make([]int, 20)
+		for i := range numbers {
+			numbers[i] = r.Intn(3)
+		}
+		return numbers
+	}
+
+	first := draw(NewPCG(1, 2))
+	assert.Equal(t, first, draw(NewPCG(1, 2)), "same seed and stream")
+	assert.NotEqual(t, first, draw(NewPCG(1, 3)), "different stream")
+	for _, n := range first {
+		assert.True(t, n >= 0 && n < 3)
+	}
+}
+
+func TestNewChaCha8(t *testing.T) {
+	draw := func(r *Generator) []int {
+		numbers := make([]int, 20)
+		for i := range numbers {
+			numbers[i] = r.Intn(1000)
+		}
+		return numbers
+	}
+	assert.Equal(t, draw(NewChaCha8(7)), draw(NewChaCha8(7)))
+	assert.NotEqual(t, draw(NewChaCha8(7)), draw(NewChaCha8(8)))
+}
+
+func TestNewCrypto(t *testing.T) {
+	r := NewCrypto()
+	counts := [3]int{}
+	for i := 0; i < 3000; i++ {
+		counts[r.Intn(3)]++
+	}
+	for _, count := range counts {
+		assert.InDelta(t, 1000, count, 150)
+	}
+}
@@ -0,0 +1,100 @@
+package random
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Names of the randomness sources accepted by New.
+const (
+	SourceCrypto  = "crypto"
+	SourcePCG     = "pcg"
+	SourceChaCha8 = "chacha8"
+	SourceReplay  = "replay"
+)
+
+// New creates the randomness source described by spec, seeding it with seed when it is seeded.
+func New(spec string, seed int64) (model.Randomizer, error) {
+	name, param, _ := strings.Cut(spec, ":")
+	switch name {
+	case SourceCrypto:
+		return NewCrypto(), nil
+	case SourcePCG:
+		return NewPCG(uint64(seed), 0), nil
+	case SourceChaCha8:
+		return NewChaCha8(seed), nil
+	case SourceReplay:
+		f, err := os.Open(param)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		return NewReplay(f)
+	}
+	return nil, fmt.Errorf("unknown random source %q (available: crypto, pcg, chacha8, replay:<file>)", spec)
+}
+
+// Seeded reports whether the source described by spec depends on the seed.
+func Seeded(spec string) bool {
+	name, _, _ := strings.Cut(spec, ":")
+	return name == SourcePCG || name == SourceChaCha8
+}
+
+// Replay returns pre-recorded numbers in order, starting over after the last one. Each number is
+// reduced modulo n, so a recording replays the same moves as long as the calls are the same.
+type Replay struct {
+	numbers []int
+	next    int
+}
+
+// NewReplay reads the numbers to replay, one per line; empty lines and lines starting with # are skipped.
+func NewReplay(r io.Reader) (*Replay, error) {
+	replay := &Replay{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		n, err := strconv.Atoi(text)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("line %d: invalid number %q", line, text)
+		}
+		replay.numbers = append(replay.numbers, n)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(replay.numbers) == 0 {
+		return nil, fmt.Errorf("no numbers to replay")
+	}
+	return replay, nil
+}
+
+func (r *Replay) Intn(n int) int {
+	number := r.numbers[r.next] % n
+	r.next = (r.next + 1) % len(r.numbers)
+	return number
+}
+
+// Recorder writes every number drawn from a randomizer, one per line, so it can be replayed.
+type Recorder struct {
+	random model.Randomizer
+	out    io.Writer
+}
+
+func NewRecorder(random model.Randomizer, out io.Writer) *Recorder {
+	return &Recorder{random: random, out: out}
+}
+
+func (r *Recorder) Intn(n int) int {
+	number := r.random.Intn(n)
+	_, _ = fmt.Fprintln(r.out, number)
+	return number
+}
@@ -0,0 +1,63 @@
+package random
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	replayPath := filepath.Join(t.TempDir(), "numbers.txt")
+	assert.NoError(t, os.WriteFile(replayPath, []byte("2\n0\n"), 0o644))
+
+	tests := []struct {
+		spec       string
+		wantSeeded bool
+		wantErr    bool
+	}{
+		{"crypto", false, false},
+		{"pcg", true, false},
+		{"chacha8", true, false},
+		{"replay:" + replayPath, false, false},
+		{"replay:" + filepath.Join(t.TempDir(), "missing.txt"), false, true},
+		{"dice", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			r, err := New(tt.spec, 1)
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			assert.Equal(t, tt.wantSeeded, Seeded(tt.spec))
+			if !tt.wantErr {
+				assert.Less(t, r.Intn(3), 3)
+			}
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	r, err := NewReplay(strings.NewReader("# recorded session\n2\n\n1\n5\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 1, 2, 2, 1}, []int{r.Intn(3), r.Intn(3), r.Intn(3), r.Intn(3), r.Intn(3)},
+		"numbers are reduced modulo n and start over after the last one")
+
+	_, err = NewReplay(strings.NewReader("1\nrock\n"))
+	assert.ErrorContains(t, err, "line 2")
+	_, err = NewReplay(strings.NewReader("-1\n"))
+	assert.Error(t, err)
+	_, err = NewReplay(strings.NewReader("# nothing\n"))
+	assert.Error(t, err)
+}
+
+func TestRecorder(t *testing.T) {
+	out := &bytes.Buffer{}
+	recorder := NewRecorder(NewPCG(1, 1), out)
+	drawn := []int{recorder.Intn(3), recorder.Intn(3), recorder.Intn(3)}
+
+	replay, err := NewReplay(out)
+	assert.NoError(t, err)
+	assert.Equal(t, drawn, []int{replay.Intn(3), replay.Intn(3), replay.Intn(3)})
+}
@@ -0,0 +1,137 @@
+// Package rating rates humans and computer strategies with Glicko-2, replaying the match history.
+package rating
+
+import (
+	"math"
+	"time"
+)
+
+// glickoScale converts between the Glicko scale and the Glicko-2 scale.
+const glickoScale = 173.7178
+
+// convergence is the tolerance of the volatility iteration.
+const convergence = 1e-6
+
+// Config holds the parameters of the Glicko-2 system.
+type Config struct {
+	// Tau constrains how fast the volatility changes, usually between 0.3 and 1.2.
+	Tau               float64 `json:"tau"`
+	InitialRating     float64 `json:"initial_rating"`
+	InitialDeviation  float64 `json:"initial_deviation"`
+	InitialVolatility float64 `json:"initial_volatility"`
+	// Period is the inactivity after which the deviation grows as for a rating period without games.
+	Period time.Duration `json:"period"`
+	// Carry is the share of the distance to the initial rating a player keeps at the start of a season.
+	Carry float64 `json:"carry"`
+}
+
+// DefaultConfig returns the parameters recommended by Glickman, with rating periods of a week, and
+// seasons keeping half of the ratings.
+func DefaultConfig() Config {
+	return Config{
+		Tau:               0.5,
+		InitialRating:     1500,
+		InitialDeviation:  350,
+		InitialVolatility: 0.06,
+		Period:            7 * 24 * time.Hour,
+		Carry:             0.5,
+	}
+}
+
+// Rating is the Glicko-2 rating of a player, on the Glicko scale.
+type Rating struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+}
+
+// Result is the score of a game against an opponent: 1 for a win, 0.5 for a draw and 0 for a loss.
+type Result struct {
+	Opponent Rating
+	Score    float64
+}
+
+// NewRating returns the rating of a player who never played.
+func (r Config) NewRating() Rating {
+	return Rating{Rating: r.InitialRating, Deviation: r.InitialDeviation, Volatility: r.InitialVolatility}
+}
+
+// Idle returns the rating after the inactivity: the deviation grows by the volatility for every
+// rating period without games, up to the deviation of a new player.
+func (r Config) Idle(rating Rating, inactivity time.Duration) Rating {
+	if r.Period <= 0 || inactivity < r.Period {
+		return rating
+	}
+	periods := float64(inactivity / r.Period)
+	phi := rating.Deviation / glickoScale
+	phi = math.Sqrt(phi*phi + periods*rating.Volatility*rating.Volatility)
+	rating.Deviation = math.Min(phi*glickoScale, r.InitialDeviation)
+	return rating
+}
+
+// Update returns the rating after a rating period with the results, following the steps of
+// "Example of the Glicko-2 system" by Mark E. Glickman.
+func (r Config) Update(rating Rating, results []Result) Rating {
+	mu := (rating.Rating - 1500) / glickoScale
+	phi := rating.Deviation / glickoScale
+	if len(results) == 0 {
+		rating.Deviation = math.Sqrt(phi*phi+rating.Volatility*rating.Volatility) * glickoScale
+		return rating
+	}
+
+	invV, improvement := 0.0, 0.0
+	for _, result := range results {
+		muJ := (result.Opponent.Rating - 1500) / glickoScale
+		gJ := g(result.Opponent.Deviation / glickoScale)
+		e := 1 / (1 + math.Exp(-gJ*(mu-muJ)))
+		invV += gJ * gJ * e * (1 - e)
+		improvement += gJ * (result.Score - e)
+	}
+	v := 1 / invV
+	delta := v * improvement
+
+	sigma := r.volatility(phi, v, delta, rating.Volatility)
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phi = 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	mu += phi * phi * improvement
+
+	return Rating{Rating: mu*glickoScale + 1500, Deviation: phi * glickoScale, Volatility: sigma}
+}
+
+// volatility solves for the new volatility with the Illinois algorithm.
+func (r Config) volatility(phi, v, delta, sigma float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-d)/(2*d*d) - (x-a)/(r.Tau*r.Tau)
+	}
+
+	// A and B bracket the solution, named as in the paper
+	A, B := a, 0.0
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*r.Tau) < 0 {
+			k++
+		}
+		B = a - k*r.Tau
+	}
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > convergence {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	return math.Exp(A / 2)
+}
+
+func g(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
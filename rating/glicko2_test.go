@@ -0,0 +1,52 @@
+package rating
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Update(t *testing.T) {
+	// the example of "Example of the Glicko-2 system" by Mark E. Glickman
+	cfg := DefaultConfig()
+	player := Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	results := []Result{
+		{Opponent: Rating{Rating: 1400, Deviation: 30}, Score: 1},
+		{Opponent: Rating{Rating: 1550, Deviation: 100}, Score: 0},
+		{Opponent: Rating{Rating: 1700, Deviation: 300}, Score: 0},
+	}
+
+	updated := cfg.Update(player, results)
+	assert.InDelta(t, 1464.06, updated.Rating, 0.01)
+	assert.InDelta(t, 151.52, updated.Deviation, 0.01)
+	assert.InDelta(t, 0.05999, updated.Volatility, 0.00001)
+}
+
+func TestConfig_Update_noGames(t *testing.T) {
+	cfg := DefaultConfig()
+	updated := cfg.Update(Rating{Rating: 1500, Deviation: 200, Volatility: 0.06}, nil)
+	assert.Equal(t, 1500.0, updated.Rating)
+	assert.InDelta(t, 200.27, updated.Deviation, 0.01)
+}
+
+func TestConfig_Idle(t *testing.T) {
+	cfg := DefaultConfig()
+	rating := Rating{Rating: 1600, Deviation: 50, Volatility: 0.06}
+	tests := []struct {
+		name          string
+		inactivity    time.Duration
+		wantDeviation float64
+	}{
+		{"less than a period", 6 * 24 * time.Hour, 50},
+		{"two periods", 15 * 24 * time.Hour, 52.13},
+		{"years", 100 * 365 * 24 * time.Hour, 350},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idle := cfg.Idle(rating, tt.inactivity)
+			assert.Equal(t, rating.Rating, idle.Rating)
+			assert.InDelta(t, tt.wantDeviation, idle.Deviation, 0.01)
+		})
+	}
+}
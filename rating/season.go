@@ -0,0 +1,134 @@
+package rating
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Season is a period of the ladder, from Start included to End excluded. The ratings are soft reset
+// at its start and its standings archived at its end.
+type Season struct {
+	Name  string    `json:"name"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Standings are the ranking and stats of a season.
+type Standings struct {
+	Season Season `json:"season"`
+	Games  int    `json:"games"`
+	Rounds int    `json:"rounds"`
+	// Players holds the players who played in the season, ranked at its end. It is only filled in
+	// once the season is archived.
+	Players []Player `json:"players,omitempty"`
+}
+
+// SoftReset returns the rating at the start of a season: it keeps the Carry share of its distance to
+// the rating of a new player, and its deviation grows by the rest of the way to the initial one.
+func (r Config) SoftReset(rating Rating) Rating {
+	rating.Rating = r.InitialRating + r.Carry*(rating.Rating-r.InitialRating)
+	rating.Deviation += (1 - r.Carry) * (r.InitialDeviation - rating.Deviation)
+	return rating
+}
+
+// AddSeason schedules the season, which must not overlap the others.
+func (r *Table) AddSeason(season Season) error {
+	if season.Name == "" {
+		return errors.New("the season needs a name")
+	}
+	if !season.End.After(season.Start) {
+		return fmt.Errorf("season %s ends before it starts", season.Name)
+	}
+	for _, other := range r.Seasons {
+		if other.Name == season.Name {
+			return fmt.Errorf("season %s already exists", season.Name)
+		}
+		if season.Start.Before(other.End) && other.Start.Before(season.End) {
+			return fmt.Errorf("season %s overlaps season %s", season.Name, other.Name)
+		}
+	}
+	r.Seasons = append(r.Seasons, season)
+	slices.SortFunc(r.Seasons, func(a, b Season) int { return a.Start.Compare(b.Start) })
+	return nil
+}
+
+// RemoveSeason removes the season from the schedule.
+func (r *Table) RemoveSeason(name string) error {
+	i := slices.IndexFunc(r.Seasons, func(season Season) bool { return season.Name == name })
+	if i < 0 {
+		return fmt.Errorf("no season %s", name)
+	}
+	r.Seasons = slices.Delete(r.Seasons, i, i+1)
+	return nil
+}
+
+// Advance archives the seasons that are over at the time, and starts the one under way, soft
+// resetting the ratings at the start of every season.
+func (r *Table) Advance(now time.Time) {
+	for _, season := range r.Seasons {
+		if season.Start.After(now) {
+			return
+		}
+		if r.archived(season.Name) {
+			continue
+		}
+		if r.Current == nil || r.Current.Season.Name != season.Name {
+			r.startSeason(season)
+		}
+		if now.Before(season.End) {
+			return
+		}
+		r.archiveSeason()
+	}
+}
+
+func (r *Table) startSeason(season Season) {
+	if r.Current != nil {
+		r.archiveSeason()
+	}
+	r.Current = &Standings{Season: season}
+	for _, player := range r.Players {
+		player.Rating = r.Config.SoftReset(player.Rating)
+		player.SeasonGames, player.SeasonWins = 0, 0
+	}
+}
+
+func (r *Table) archiveSeason() {
+	standings := *r.Current
+	standings.Players = r.seasonRanking(standings.Season.End)
+	r.Archive = append(r.Archive, standings)
+	r.Current = nil
+}
+
+// seasonRanking ranks the players who played in the current season.
+func (r *Table) seasonRanking(at time.Time) []Player {
+	players := []Player{}
+	for _, player := range r.Ranking(at) {
+		if player.SeasonGames > 0 {
+			players = append(players, player)
+		}
+	}
+	return players
+}
+
+func (r *Table) archived(name string) bool {
+	return slices.ContainsFunc(r.Archive, func(standings Standings) bool { return standings.Season.Name == name })
+}
+
+// Standings returns the standings of the season: archived once it is over, or ranked at the time
+// while it is under way. It returns false when the season has not started.
+func (r *Table) Standings(name string, now time.Time) (Standings, bool) {
+	for _, standings := range r.Archive {
+		if standings.Season.Name == name {
+			return standings, true
+		}
+	}
+	if r.Current == nil || r.Current.Season.Name != name {
+		return Standings{}, false
+	}
+	standings := *r.Current
+	standings.Players = r.seasonRanking(now)
+	return standings, true
+}
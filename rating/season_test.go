@@ -0,0 +1,77 @@
+package rating
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// season is a season of the days after the start of the rated matches.
+func season(name string, fromDay, toDay int) Season {
+	return Season{Name: name, Start: start.AddDate(0, 0, fromDay), End: start.AddDate(0, 0, toDay)}
+}
+
+func TestConfig_SoftReset(t *testing.T) {
+	cfg := DefaultConfig()
+	reset := cfg.SoftReset(Rating{Rating: 1700, Deviation: 50, Volatility: 0.07})
+	assert.Equal(t, Rating{Rating: 1600, Deviation: 200, Volatility: 0.07}, reset)
+}
+
+func TestTable_AddSeason(t *testing.T) {
+	table := NewTable(DefaultConfig())
+	assert.NoError(t, table.AddSeason(season("summer", 10, 20)))
+	assert.NoError(t, table.AddSeason(season("spring", 0, 10)))
+	assert.Equal(t, []string{"spring", "summer"}, []string{table.Seasons[0].Name, table.Seasons[1].Name})
+
+	assert.Error(t, table.AddSeason(season("", 30, 40)), "no name")
+	assert.Error(t, table.AddSeason(season("autumn", 40, 30)), "ends before it starts")
+	assert.Error(t, table.AddSeason(season("spring", 30, 40)), "same name")
+	assert.Error(t, table.AddSeason(season("autumn", 15, 40)), "overlaps summer")
+
+	assert.NoError(t, table.RemoveSeason("spring"))
+	assert.Error(t, table.RemoveSeason("spring"))
+	assert.Len(t, table.Seasons, 1)
+}
+
+func TestCompute_seasons(t *testing.T) {
+	played := ratedMatch("ANA", 12)
+	played.Rounds = make([]model.MatchRound, 3)
+	matches := []model.Match{ratedMatch("ANA", 1), ratedMatch("ANA", 2), ratedMatch("ROBOT", 11), played}
+	seasons := []Season{season("spring", 0, 10), season("empty", 10, 11), season("summer", 11, 20)}
+	table := Compute(matches, DefaultConfig(), seasons)
+
+	if assert.Len(t, table.Archive, 2) {
+		spring := table.Archive[0]
+		assert.Equal(t, "spring", spring.Season.Name)
+		assert.Equal(t, 2, spring.Games)
+		assert.Equal(t, []string{"ANA", "heuristic"}, []string{spring.Players[0].Name, spring.Players[1].Name})
+		assert.Equal(t, 2, spring.Players[0].SeasonWins)
+		assert.Equal(t, 0, spring.Players[1].SeasonWins)
+		assert.Equal(t, 0, table.Archive[1].Games)
+		assert.Empty(t, table.Archive[1].Players, "nobody played in the season")
+	}
+	if assert.NotNil(t, table.Current) {
+		assert.Equal(t, "summer", table.Current.Season.Name)
+		assert.Equal(t, 2, table.Current.Games)
+		assert.Equal(t, 3, table.Current.Rounds)
+	}
+	ana := table.Players["human:ANA"]
+	assert.Equal(t, 4, ana.Games)
+	assert.Equal(t, 2, ana.SeasonGames)
+
+	// the ratings were soft reset twice, so the lead of the spring counts less
+	continuous := Compute(matches, DefaultConfig(), nil)
+	assert.Less(t, ana.Rating.Rating, continuous.Players["human:ANA"].Rating.Rating)
+
+	summer, ok := table.Standings("summer", start.AddDate(0, 0, 13))
+	assert.True(t, ok)
+	assert.Len(t, summer.Players, 2)
+	_, ok = table.Standings("autumn", start)
+	assert.False(t, ok)
+
+	table.Advance(start.AddDate(0, 0, 20))
+	assert.Nil(t, table.Current)
+	assert.Len(t, table.Archive, 3, "the summer is over")
+}
@@ -0,0 +1,67 @@
+package rating
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// ErrRatings is returned by Store.Append when the match was saved to the history, but the ratings
+// could not be updated.
+var ErrRatings = errors.New("cannot update the ratings")
+
+// Store is a match store that updates the saved ratings after every match appended to the history.
+type Store struct {
+	model.MatchStore
+}
+
+// NewStore wraps the history store.
+func NewStore(history model.MatchStore) *Store {
+	return &Store{MatchStore: history}
+}
+
+// Append adds the match to the history, then applies it to the ratings. The ratings are recomputed
+// from the history when they do not match it, e.g. after games played by an older version.
+func (r *Store) Append(match model.Match) error {
+	if err := r.MatchStore.Append(match); err != nil {
+		return err
+	}
+	if err := r.updateRatings(match); err != nil {
+		return fmt.Errorf("%w: %v", ErrRatings, err)
+	}
+	return nil
+}
+
+// updateRatings applies the match just appended to the history to the saved ratings, locking them
+// so the updates of concurrent games are not lost.
+func (r *Store) updateRatings(match model.Match) error {
+	path, err := storage.Path(FileName)
+	if err != nil {
+		return err
+	}
+	unlock, err := storage.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	matches, err := r.MatchStore.Matches()
+	if err != nil {
+		return err
+	}
+	table, err := Load()
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		table = NewTable(DefaultConfig())
+	}
+	if table.Matches == len(matches)-1 {
+		table.Apply(match)
+	} else {
+		table = Compute(matches, table.Config, table.Seasons)
+	}
+	return Save(table)
+}
@@ -0,0 +1,159 @@
+package rating
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"time"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// TableVersion is the version of the ratings file format.
+const TableVersion = 1
+
+// FileName is the ratings file, inside the data directory.
+const FileName = "ratings.json"
+
+// Player is a rated human, by the name of their profile, or computer strategy.
+type Player struct {
+	Name  string `json:"name"`
+	Human bool   `json:"human"`
+	Rating
+	Games      int       `json:"games"`
+	Wins       int       `json:"wins"`
+	LastPlayed time.Time `json:"last_played"`
+	// SeasonGames and SeasonWins count the games of the current season.
+	SeasonGames int `json:"season_games,omitempty"`
+	SeasonWins  int `json:"season_wins,omitempty"`
+}
+
+// Table holds the ratings computed from the first Matches matches of the history, and the standings
+// of the scheduled seasons.
+type Table struct {
+	Version int                `json:"version"`
+	Config  Config             `json:"config"`
+	Matches int                `json:"matches"`
+	Players map[string]*Player `json:"players"`
+	Seasons []Season           `json:"seasons,omitempty"`
+	// Current is the season under way, and Archive the standings of the seasons that are over.
+	Current *Standings  `json:"current,omitempty"`
+	Archive []Standings `json:"archive,omitempty"`
+}
+
+// NewTable creates a table without ratings.
+func NewTable(cfg Config) *Table {
+	return &Table{Version: TableVersion, Config: cfg, Players: map[string]*Player{}}
+}
+
+// Compute replays the matches, oldest first, so the ratings can be recomputed with other parameters
+// or seasons.
+func Compute(matches []model.Match, cfg Config, seasons []Season) *Table {
+	table := NewTable(cfg)
+	table.Seasons = seasons
+	for _, match := range matches {
+		table.Apply(match)
+	}
+	return table
+}
+
+// Apply updates the ratings of both sides of the finished match, each game being a rating period
+// after the inactivity of the player.
+func (r *Table) Apply(match model.Match) {
+	r.Matches++
+	r.Advance(match.EndedAt)
+	if r.Current != nil {
+		r.Current.Games++
+		r.Current.Rounds += len(match.Rounds)
+	}
+	players := [2]*Player{r.player(match, 0), r.player(match, 1)}
+	if players[0] == players[1] {
+		// a strategy playing itself learns nothing about its strength
+		return
+	}
+	before := [2]Rating{}
+	for side, player := range players {
+		before[side] = player.Rating
+		if !player.LastPlayed.IsZero() {
+			before[side] = r.Config.Idle(player.Rating, match.EndedAt.Sub(player.LastPlayed))
+		}
+	}
+	for side, player := range players {
+		score := 0.0
+		if match.Winner == match.Players[side] {
+			score = 1
+			player.Wins++
+			player.SeasonWins++
+		}
+		player.Rating = r.Config.Update(before[side], []Result{{Opponent: before[1-side], Score: score}})
+		player.Games++
+		player.SeasonGames++
+		player.LastPlayed = match.EndedAt
+	}
+}
+
+// player returns the player of a side of the match, creating it if needed: the human by name, or
+// the strategy of the automated player.
+func (r *Table) player(match model.Match, side int) *Player {
+	name, human := match.Strategies[side], false
+	if name == "" {
+		name, human = match.Players[side], true
+	}
+	key := "strategy:" + name
+	if human {
+		key = "human:" + name
+	}
+	player, ok := r.Players[key]
+	if !ok {
+		player = &Player{Name: name, Human: human, Rating: r.Config.NewRating()}
+		r.Players[key] = player
+	}
+	return player
+}
+
+// Ranking returns the players sorted by rating, their deviation grown by their inactivity until now.
+func (r *Table) Ranking(now time.Time) []Player {
+	ranking := make([]Player, 0, len(r.Players))
+	for _, player := range r.Players {
+		rated := *player
+		rated.Rating = r.Config.Idle(player.Rating, now.Sub(player.LastPlayed))
+		ranking = append(ranking, rated)
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Rating.Rating != ranking[j].Rating.Rating {
+			return ranking[i].Rating.Rating > ranking[j].Rating.Rating
+		}
+		return ranking[i].Name < ranking[j].Name
+	})
+	return ranking
+}
+
+// Load reads the ratings of the data directory, or returns nil when there are none yet.
+func Load() (*Table, error) {
+	path, err := storage.Path(FileName)
+	if err != nil {
+		return nil, err
+	}
+	table := &Table{}
+	err = storage.LoadJSON(path, table)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case table.Version != TableVersion:
+		return nil, fmt.Errorf("%s: unsupported ratings version %d, want %d", path, table.Version, TableVersion)
+	}
+	return table, nil
+}
+
+// Save writes the ratings to the data directory.
+func Save(table *Table) error {
+	path, err := storage.Path(FileName)
+	if err != nil {
+		return err
+	}
+	return storage.SaveJSON(path, table)
+}
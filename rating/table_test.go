@@ -0,0 +1,130 @@
+package rating
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/history"
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+var start = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+// ratedMatch is a game of ANA against the heuristic of the computer, ended after the days.
+func ratedMatch(winner string, days int) model.Match {
+	return model.Match{
+		Players:    [2]string{"ANA", "ROBOT"},
+		Strategies: [2]string{"", "heuristic"},
+		Winner:     winner,
+		EndedAt:    start.Add(time.Duration(days) * 24 * time.Hour),
+	}
+}
+
+func TestCompute(t *testing.T) {
+	matches := []model.Match{ratedMatch("ROBOT", 0), ratedMatch("ANA", 1), ratedMatch("ANA", 30)}
+	table := Compute(matches, DefaultConfig(), nil)
+
+	assert.Equal(t, 3, table.Matches)
+	ana, robot := table.Players["human:ANA"], table.Players["strategy:heuristic"]
+	assert.Equal(t, 3, ana.Games)
+	assert.Equal(t, 2, ana.Wins)
+	assert.Equal(t, 1, robot.Wins)
+	assert.Equal(t, 3, robot.Games)
+	assert.Greater(t, ana.Rating.Rating, 1500.0)
+	assert.Less(t, robot.Rating.Rating, 1500.0)
+	assert.Less(t, ana.Deviation, 350.0)
+	assert.Equal(t, matches[2].EndedAt, ana.LastPlayed)
+
+	ranking := table.Ranking(matches[2].EndedAt)
+	assert.Equal(t, []string{"ANA", "heuristic"}, []string{ranking[0].Name, ranking[1].Name})
+	assert.True(t, ranking[0].Human)
+	later := table.Ranking(matches[2].EndedAt.Add(365 * 24 * time.Hour))
+	assert.Greater(t, later[0].Deviation, ranking[0].Deviation, "the deviation grows with inactivity")
+
+	// the inactivity before the last game makes it count more than without it
+	recent := Compute([]model.Match{ratedMatch("ROBOT", 0), ratedMatch("ANA", 1), ratedMatch("ANA", 2)}, DefaultConfig(), nil)
+	assert.Greater(t, ana.Rating.Rating, recent.Players["human:ANA"].Rating.Rating)
+}
+
+func TestCompute_selfPlay(t *testing.T) {
+	match := model.Match{Players: [2]string{"CYCLER", "CYCLER"}, Strategies: [2]string{"cycler", "cycler"}, Winner: "CYCLER"}
+	table := Compute([]model.Match{match}, DefaultConfig(), nil)
+	assert.Equal(t, 1, table.Matches)
+	assert.Equal(t, 0, table.Players["strategy:cycler"].Games)
+}
+
+func TestCompute_automatedPlayers(t *testing.T) {
+	// each bot is rated by its handshake name and each ghost by the player it imitates
+	matches := []model.Match{
+		{Players: [2]string{"ANA", "COPY CAT"}, Strategies: [2]string{"", "bot:COPY CAT"}, Winner: "ANA"},
+		{Players: [2]string{"ANA", "ALWAYS ROCK"}, Strategies: [2]string{"", "bot:ALWAYS ROCK"}, Winner: "ALWAYS ROCK"},
+		{Players: [2]string{"ANA", "ROBOT"}, Strategies: [2]string{"", "ghost:ANA"}, Winner: "ANA"},
+		{Players: [2]string{"ANA", "ROBOT"}, Strategies: [2]string{"", "ghost:PAUL"}, Winner: "ANA"},
+	}
+	table := Compute(matches, DefaultConfig(), nil)
+	for _, key := range []string{"strategy:bot:COPY CAT", "strategy:bot:ALWAYS ROCK", "strategy:ghost:ANA", "strategy:ghost:PAUL"} {
+		if assert.Contains(t, table.Players, key) {
+			assert.Equal(t, 1, table.Players[key].Games, key)
+		}
+	}
+	assert.Equal(t, 1, table.Players["strategy:bot:ALWAYS ROCK"].Wins)
+}
+
+func TestStore(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	matches := history.NewMemory()
+	assert.NoError(t, matches.Append(ratedMatch("ANA", 0)))
+	store := NewStore(matches)
+
+	assert.NoError(t, store.Append(ratedMatch("ANA", 1)))
+	table, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, table.Matches, "the ratings are recomputed with the match played before")
+
+	assert.NoError(t, store.Append(ratedMatch("ROBOT", 2)))
+	table, err = Load()
+	assert.NoError(t, err)
+	all, _ := matches.Matches()
+	assert.Equal(t, Compute(all, DefaultConfig(), nil), table, "updating after every game gives the recomputed ratings")
+}
+
+func TestStore_concurrent(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), history.FileName)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// every game opens its own stores, as separate sessions would
+			assert.NoError(t, NewStore(history.NewFile(path)).Append(ratedMatch("ANA", i)))
+		}()
+	}
+	wg.Wait()
+
+	table, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 20, table.Matches, "no rating update is lost")
+	assert.Equal(t, 20, table.Players["human:ANA"].Games)
+}
+
+func TestStore_ratingsError(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	path, err := storage.Path(FileName)
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	assert.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+	matches := history.NewMemory()
+	store := NewStore(matches)
+
+	err = store.Append(ratedMatch("ANA", 0))
+	assert.ErrorIs(t, err, ErrRatings)
+	saved, _ := matches.Matches()
+	assert.Len(t, saved, 1, "the match is saved even though the ratings are not")
+}
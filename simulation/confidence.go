@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"math"
+)
+
+// z95 is the standard normal quantile of a two-sided 95% confidence interval.
+const z95 = 1.959964
+
+// Interval is a confidence interval.
+type Interval struct {
+	Low, High float64
+}
+
+// WinRate returns the share of games won by player 1 and its 95% Wilson score interval.
+func (r Result) WinRate() (float64, Interval) {
+	return proportion(r.Games.Wins, r.Games.Total())
+}
+
+// RoundWinRate returns the share of rounds won by player 1 and its 95% Wilson score interval.
+func (r Result) RoundWinRate() (float64, Interval) {
+	return proportion(r.Rounds.Wins, r.Rounds.Total())
+}
+
+// MeanGameLength returns the mean number of rounds per game and its 95% confidence interval.
+func (r Result) MeanGameLength() (float64, Interval) {
+	n := float64(r.Games.Total())
+	if n == 0 {
+		return 0, Interval{}
+	}
+	sum := float64(r.Rounds.Total())
+	mean := sum / n
+	if n < 2 {
+		return mean, Interval{mean, mean}
+	}
+
+	variance := (float64(r.lengthSquares) - sum*mean) / (n - 1)
+	margin := z95 * math.Sqrt(max(variance, 0)) / math.Sqrt(n)
+	return mean, Interval{mean - margin, mean + margin}
+}
+
+// proportion returns successes/n and its Wilson score interval, which stays meaningful near 0 and 1.
+func proportion(successes, n int) (float64, Interval) {
+	if n == 0 {
+		return 0, Interval{}
+	}
+	p := float64(successes) / float64(n)
+	z2 := z95 * z95
+	total := float64(n)
+	center := (p + z2/(2*total)) / (1 + z2/total)
+	margin := z95 * math.Sqrt(p*(1-p)/total+z2/(4*total*total)) / (1 + z2/total)
+	return p, Interval{center - margin, center + margin}
+}
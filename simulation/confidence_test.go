@@ -0,0 +1,61 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResult_WinRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		games    Outcome
+		wantRate float64
+		wantCI   Interval
+	}{
+		{"no games", Outcome{}, 0, Interval{}},
+		{"half of the games", Outcome{Wins: 50, Losses: 50}, 0.5, Interval{0.4038, 0.5962}},
+		{"every game", Outcome{Wins: 20}, 1, Interval{0.8389, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, ci := Result{Games: tt.games}.WinRate()
+			assert.InDelta(t, tt.wantRate, rate, 1e-9)
+			assert.InDelta(t, tt.wantCI.Low, ci.Low, 1e-4)
+			assert.InDelta(t, tt.wantCI.High, ci.High, 1e-4)
+		})
+	}
+}
+
+func TestResult_RoundWinRate(t *testing.T) {
+	rate, ci := Result{Rounds: Outcome{Wins: 1, Draws: 1, Losses: 2}}.RoundWinRate()
+	assert.Equal(t, 0.25, rate)
+	assert.Less(t, ci.Low, 0.25)
+	assert.Greater(t, ci.High, 0.25)
+}
+
+func TestResult_MeanGameLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		lengths  []int
+		wantMean float64
+		wantCI   Interval
+	}{
+		{"no games", nil, 0, Interval{}},
+		{"single game", []int{4}, 4, Interval{4, 4}},
+		// sample standard deviation 1.5811, margin 1.96 * 1.5811 / sqrt(5)
+		{"several games", []int{3, 4, 5, 6, 7}, 5, Interval{3.6141, 6.3859}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Result{}
+			for _, length := range tt.lengths {
+				result.add(GameResult{Rounds: Outcome{Draws: length}})
+			}
+			mean, ci := result.MeanGameLength()
+			assert.InDelta(t, tt.wantMean, mean, 1e-9)
+			assert.InDelta(t, tt.wantCI.Low, ci.Low, 1e-4)
+			assert.InDelta(t, tt.wantCI.High, ci.High, 1e-4)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// outcomes holds the result of every pair of moves for the first move: 1 win, 0 draw, -1 loss.
+// Index 0 is the missing move of a forfeit, which loses against any legal move.
+var outcomes = func() (table [4][4]int8) {
+	for a := range table {
+		for b := range table[a] {
+			switch {
+			case model.Beats(model.Move(a), model.Move(b)):
+				table[a][b] = 1
+			case model.Beats(model.Move(b), model.Move(a)):
+				table[a][b] = -1
+			}
+		}
+	}
+	return table
+}()
+
+// PlayGame plays rounds until a strategy reaches the winning score or the round limit is hit.
+// It works on strategies directly and does not allocate.
+func PlayGame(s1, s2 model.Strategy, winningScore, maxRounds int) GameResult {
+	result := GameResult{}
+	for round := 0; round < maxRounds; round++ {
+		m1, m2 := s1.Next(), s2.Next()
+		switch outcomes[m1][m2] {
+		case 1:
+			result.Rounds.Wins++
+		case -1:
+			result.Rounds.Losses++
+		default:
+			result.Rounds.Draws++
+		}
+		s1.Observe(m1, m2)
+		s2.Observe(m2, m1)
+
+		switch {
+		case result.Rounds.Wins >= winningScore:
+			result.Winner = 1
+			return result
+		case result.Rounds.Losses >= winningScore:
+			result.Winner = 2
+			return result
+		}
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+func Test_outcomes(t *testing.T) {
+	for _, a := range append([]model.Move{0}, model.Moves...) {
+		for _, b := range append([]model.Move{0}, model.Moves...) {
+			assert.Equal(t, model.Beats(a, b), outcomes[a][b] == 1, "%d vs %d", a, b)
+			assert.Equal(t, model.Beats(b, a), outcomes[a][b] == -1, "%d vs %d", a, b)
+		}
+	}
+}
+
+func TestPlayGame_allocations(t *testing.T) {
+	randomizer := random.NewPCG(1, 1)
+	s1, _ := strategies.New("human", randomizer)
+	s2, _ := strategies.New("computer", randomizer)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		s1.Reset()
+		s2.Reset()
+		PlayGame(s1, s2, 3, 100)
+	})
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkPlayGame(b *testing.B) {
+	randomizer := random.NewPCG(1, 1)
+	s1, _ := strategies.New("human", randomizer)
+	s2, _ := strategies.New("computer", randomizer)
+	b.ReportAllocs()
+
+	rounds := 0
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		s1.Reset()
+		s2.Reset()
+		rounds += PlayGame(s1, s2, 3, 1000).Rounds.Total()
+	}
+	b.ReportMetric(float64(rounds)/time.Since(start).Seconds(), "rounds/s")
+}
+
+func BenchmarkRun(b *testing.B) {
+	cfg := Config{P1: "human", P2: "computer", Games: 10000, Seed: 1, WinningScore: 3, MaxRounds: 1000}
+
+	rounds := 0
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		result, err := Run(cfg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		rounds += result.Rounds.Total()
+	}
+	b.ReportMetric(float64(rounds)/time.Since(start).Seconds(), "rounds/s")
+}
@@ -0,0 +1,148 @@
+// Package simulation plays headless games between strategies, without sleeps, spinner or screen clears.
+package simulation
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+// shardSize is the number of games of a shard. Shards, not workers, own the seeds, so results do
+// not depend on the number of workers.
+const shardSize = 256
+
+// Config configures a batch of games between two strategies.
+type Config struct {
+	// P1 and P2 are strategy specs, as accepted by strategies.New.
+	P1, P2 string
+	Games  int
+	Seed   int64
+	// WinningScore is the number of rounds a player needs to win a game.
+	WinningScore int
+	// MaxRounds ends a game as a draw when nobody reaches the winning score in time.
+	MaxRounds int
+	// Workers is the number of goroutines playing games, GOMAXPROCS when zero.
+	Workers int
+}
+
+// Outcome counts wins, draws and losses from the point of view of player 1.
+type Outcome struct {
+	Wins   int
+	Draws  int
+	Losses int
+}
+
+// Total returns the number of counted results.
+func (r Outcome) Total() int {
+	return r.Wins + r.Draws + r.Losses
+}
+
+// Result summarizes a batch of games.
+type Result struct {
+	Config Config
+	Games  Outcome
+	Rounds Outcome
+	// lengthSquares is the sum of the squared game lengths, kept as an integer so merging
+	// shards in any order gives the same result.
+	lengthSquares int64
+}
+
+// GameResult is the outcome of a single game.
+type GameResult struct {
+	Winner int // 1 or 2, 0 when the game reached the round limit
+	Rounds Outcome
+}
+
+// Run plays the configured games in shards of shardSize games spread over the workers. Shard k is
+// seeded with (Seed, k), so the same seed reproduces the same result with any number of workers.
+func Run(cfg Config) (Result, error) {
+	if cfg.Games < 1 || cfg.WinningScore < 1 || cfg.MaxRounds < 1 {
+		return Result{}, errors.New("games, winning score and max rounds must be positive")
+	}
+	workers := cfg.Workers
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	shards := (cfg.Games + shardSize - 1) / shardSize
+	workers = min(workers, shards)
+
+	partials := make([]Result, shards)
+	errs := make([]error, shards)
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				shard := int(next.Add(1) - 1)
+				if shard >= shards {
+					return
+				}
+				partials[shard], errs[shard] = runShard(cfg, shard)
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := Result{Config: cfg}
+	for shard, partial := range partials {
+		if errs[shard] != nil {
+			return Result{}, errs[shard]
+		}
+		result.merge(partial)
+	}
+	return result, nil
+}
+
+// runShard plays the games of a shard with one pair of strategies, reset between games.
+func runShard(cfg Config, shard int) (Result, error) {
+	randomizer := random.NewPCG(uint64(cfg.Seed), uint64(shard))
+	s1, err := strategies.New(cfg.P1, randomizer)
+	if err != nil {
+		return Result{}, err
+	}
+	s2, err := strategies.New(cfg.P2, randomizer)
+	if err != nil {
+		return Result{}, err
+	}
+
+	games := min(shardSize, cfg.Games-shard*shardSize)
+	result := Result{}
+	for i := 0; i < games; i++ {
+		s1.Reset()
+		s2.Reset()
+		result.add(PlayGame(s1, s2, cfg.WinningScore, cfg.MaxRounds))
+	}
+	return result, nil
+}
+
+func (r *Result) add(game GameResult) {
+	switch game.Winner {
+	case 1:
+		r.Games.Wins++
+	case 2:
+		r.Games.Losses++
+	default:
+		r.Games.Draws++
+	}
+	r.Rounds.Wins += game.Rounds.Wins
+	r.Rounds.Draws += game.Rounds.Draws
+	r.Rounds.Losses += game.Rounds.Losses
+	length := int64(game.Rounds.Total())
+	r.lengthSquares += length * length
+}
+
+func (r *Result) merge(other Result) {
+	r.Games.Wins += other.Games.Wins
+	r.Games.Draws += other.Games.Draws
+	r.Games.Losses += other.Games.Losses
+	r.Rounds.Wins += other.Rounds.Wins
+	r.Rounds.Draws += other.Rounds.Draws
+	r.Rounds.Losses += other.Rounds.Losses
+	r.lengthSquares += other.lengthSquares
+}
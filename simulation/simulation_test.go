@@ -0,0 +1,91 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+func TestPlayGame(t *testing.T) {
+	tests := []struct {
+		name       string
+		p1, p2     string
+		maxRounds  int
+		wantResult GameResult
+	}{
+		{
+			name: "cycler beats rock",
+			p1:   "rock",
+			p2:   "cycler",
+			// rounds: draw, loss, win, draw, loss, win, draw, loss
+			maxRounds:  100,
+			wantResult: GameResult{Winner: 2, Rounds: Outcome{Wins: 2, Draws: 3, Losses: 3}},
+		},
+		{
+			name:       "round limit makes it a draw",
+			p1:         "rock",
+			p2:         "rock",
+			maxRounds:  10,
+			wantResult: GameResult{Winner: 0, Rounds: Outcome{Draws: 10}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s1, err := strategies.New(tt.p1, nil)
+			assert.NoError(t, err)
+			s2, err := strategies.New(tt.p2, nil)
+			assert.NoError(t, err)
+
+			got := PlayGame(s1, s2, 3, tt.maxRounds)
+			assert.Equal(t, tt.wantResult, got)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	cfg := Config{P1: "computer", P2: "human", Games: 200, Seed: 42, WinningScore: 3, MaxRounds: 100}
+
+	first, err := Run(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, first.Games.Total())
+	assert.GreaterOrEqual(t, first.Rounds.Total(), 3*200)
+
+	second, err := Run(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second, "the same seed reproduces the same games")
+}
+
+func TestRun_workers(t *testing.T) {
+	cfg := Config{P1: "human", P2: "wsls", Games: 3*shardSize + 10, Seed: 7, WinningScore: 3, MaxRounds: 100}
+
+	cfg.Workers = 1
+	sequential, err := Run(cfg)
+	assert.NoError(t, err)
+
+	cfg.Workers = 8
+	parallel, err := Run(cfg)
+	assert.NoError(t, err)
+
+	sequential.Config.Workers = 8
+	assert.Equal(t, sequential, parallel, "results do not depend on the number of workers")
+	assert.Equal(t, cfg.Games, parallel.Games.Total())
+}
+
+func TestRun_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"no games", Config{P1: "rock", P2: "rock", Games: 0, WinningScore: 3, MaxRounds: 10}},
+		{"unknown p1", Config{P1: "lizard", P2: "rock", Games: 1, WinningScore: 3, MaxRounds: 10}},
+		{"unknown p2", Config{P1: "rock", P2: "spock", Games: 1, WinningScore: 3, MaxRounds: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Run(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
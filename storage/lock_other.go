@@ -0,0 +1,15 @@
+//go:build !unix
+
+package storage
+
+import "os"
+
+// LockFile does nothing where flock is not available: appends of a single write still do not interleave.
+func LockFile(*os.File, bool) error {
+	return nil
+}
+
+// UnlockFile does nothing where flock is not available.
+func UnlockFile(*os.File) error {
+	return nil
+}
@@ -0,0 +1,37 @@
+//go:build unix
+
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "ratings.json")
+	unlock, err := Lock(path)
+	assert.NoError(t, err)
+
+	locked := make(chan struct{})
+	go func() {
+		unlockAgain, err := Lock(path)
+		assert.NoError(t, err)
+		close(locked)
+		unlockAgain()
+	}()
+	select {
+	case <-locked:
+		t.Fatal("the lock was taken twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the lock was not released")
+	}
+}
@@ -0,0 +1,137 @@
+// Package storage locates and writes the files the game keeps between runs, such as trained strategies.
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const appName = "rock-paper-scissors"
+
+// DataDir returns the directory of the game data: $XDG_DATA_HOME/rock-paper-scissors, or
+// ~/.local/share/rock-paper-scissors when XDG_DATA_HOME is not set.
+func DataDir() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, appName), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.New("cannot locate the data directory: set XDG_DATA_HOME")
+	}
+	return filepath.Join(home, ".local", "share", appName), nil
+}
+
+// Path returns the path of a file inside the data directory.
+func Path(elem ...string) (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(append([]string{dir}, elem...)...), nil
+}
+
+// FileName returns the name of the JSON file of the record called name. Names made of letters,
+// digits, dashes and underscores are kept as they are. Other characters are replaced with
+// underscores, and a short hash of the name is appended after a dot, which kept names never
+// contain, so distinct names do not share a file.
+func FileName(name string) string {
+	safe := sanitize(name)
+	if safe == name {
+		return name + ".json"
+	}
+	sum := sha256.Sum256([]byte(name))
+	return safe + "." + hex.EncodeToString(sum[:6]) + ".json"
+}
+
+// sanitize keeps letters, digits, dashes and underscores of the name and replaces anything else.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// NamedPath returns the path of the JSON file of the record called name in dir, see FileName. Older
+// versions saved the records under the sanitized name alone, which distinct names could share: such a
+// file is moved to the path first when its "name" field is the name.
+func NamedPath(dir, name string) (string, error) {
+	path := filepath.Join(dir, FileName(name))
+	legacy := filepath.Join(dir, sanitize(name)+".json")
+	if legacy == path {
+		return path, nil
+	}
+	record := struct {
+		Name string `json:"name"`
+	}{}
+	if err := LoadJSON(legacy, &record); err != nil || record.Name != name {
+		return path, nil
+	}
+	if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+		return path, nil
+	}
+	return path, os.Rename(legacy, path)
+}
+
+// SaveJSON writes v as indented JSON, creating the parent directories. The file is replaced
+// atomically, so a crash never leaves it half written.
+func SaveJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err = tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Lock takes the exclusive lock of the file, for a read-modify-write that must not interleave with
+// another process doing the same, and returns the function releasing it. The lock is held on a
+// separate lock file, as SaveJSON replaces the file itself.
+func Lock(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err = LockFile(f, true); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return func() {
+		_ = UnlockFile(f)
+		_ = f.Close()
+	}, nil
+}
+
+// LoadJSON reads the JSON file into v.
+func LoadJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataDir(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", "/data")
+	dir, err := DataDir()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("/data", appName), dir)
+
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("HOME", "/home/player")
+	dir, err = DataDir()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("/home/player", ".local", "share", appName), dir)
+}
+
+func TestSaveJSON_LoadJSON(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	path, err := Path("nested", "file.json")
+	assert.NoError(t, err)
+
+	type file struct {
+		Version int
+		Values  []int
+	}
+	assert.NoError(t, SaveJSON(path, file{Version: 1, Values: []int{1, 2}}))
+
+	loaded := file{}
+	assert.NoError(t, LoadJSON(path, &loaded))
+	assert.Equal(t, file{Version: 1, Values: []int{1, 2}}, loaded)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary file is left behind")
+
+	assert.Error(t, LoadJSON(filepath.Join(t.TempDir(), "missing.json"), &loaded))
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "ANA.json", FileName("ANA"), "safe names are kept")
+	assert.Equal(t, "JEAN-PAUL_2.json", FileName("JEAN-PAUL_2"))
+	assert.True(t, strings.HasPrefix(FileName("ANA!"), "ANA_."))
+	assert.NotEqual(t, FileName("ANA!"), FileName("ANA?"), "distinct names get distinct files")
+	assert.NotEqual(t, FileName("ANA_"), FileName("ANA!"))
+	assert.NotContains(t, FileName("../BOB"), "/")
+}
+
+func TestNamedPath(t *testing.T) {
+	dir := t.TempDir()
+	// saved by an older version under the sanitized name alone
+	legacy := filepath.Join(dir, "ANA_.json")
+	assert.NoError(t, SaveJSON(legacy, map[string]string{"name": "ANA!"}))
+
+	path, err := NamedPath(dir, "ANA?")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, FileName("ANA?")), path)
+	assert.FileExists(t, legacy, "the file of another name is left alone")
+
+	path, err = NamedPath(dir, "ANA!")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, FileName("ANA!")), path)
+	assert.NoFileExists(t, legacy, "the file of the name is moved")
+	record := map[string]string{}
+	assert.NoError(t, LoadJSON(path, &record))
+	assert.Equal(t, "ANA!", record["name"])
+}
@@ -0,0 +1,45 @@
+package strategies
+
+import (
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Heuristic is the strategy of players.Computer, driven by observed rounds instead of the game throw:
+// after a draw it plays at random, otherwise it plays the move missing from the last round.
+type Heuristic struct {
+	random       model.Randomizer
+	lastOwn      model.Move
+	lastOpponent model.Move
+}
+
+func NewHeuristic(random model.Randomizer) *Heuristic {
+	return &Heuristic{random: random}
+}
+
+func (r *Heuristic) Name() string {
+	return "computer"
+}
+
+func (r *Heuristic) Next() model.Move {
+	return HeuristicMove(r.random, r.lastOwn, r.lastOpponent)
+}
+
+// HeuristicMove is the move of the computer heuristic after a round where a and b were played: the
+// move missing from the round, or a random one after a draw or before the first round. It is shared
+// with players.Computer, so the strategy plays exactly like the opponent of the game.
+func HeuristicMove(random model.Randomizer, a, b model.Move) model.Move {
+	if !a.Valid() || !b.Valid() || a == b {
+		return randomMove(random)
+	}
+	return (model.Rock + model.Paper + model.Scissors) - a - b
+}
+
+func (r *Heuristic) Observe(own, opponent model.Move) {
+	r.lastOwn = own
+	r.lastOpponent = opponent
+}
+
+func (r *Heuristic) Reset() {
+	r.lastOwn = 0
+	r.lastOpponent = 0
+}
@@ -0,0 +1,257 @@
+package strategies
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// ProgramVersion is the version of the rule program file format.
+const ProgramVersion = 1
+
+// ProgramFile is the default rule program file, inside the data directory.
+const ProgramFile = "evolved.json"
+
+// MaxLag is the oldest round a rule condition can look at.
+const MaxLag = 3
+
+// Subjects a rule condition can look at.
+const (
+	SubjectAlways   = "always"
+	SubjectOwn      = "own"
+	SubjectOpponent = "opponent"
+	SubjectOutcome  = "outcome"
+)
+
+// Outcomes of a round, from the point of view of the program, as matched by SubjectOutcome conditions.
+const (
+	OutcomeWin = iota + 1
+	OutcomeDraw
+	OutcomeLoss
+)
+
+// Bases of a rule move distribution.
+const (
+	// BaseAbsolute weights are the weights of rock, paper and scissors.
+	BaseAbsolute = "absolute"
+	// BaseOwn and BaseOpponent weights are relative to the last move of the program or of its
+	// opponent: the weight of the same move, of the move that beats it and of the move it beats.
+	BaseOwn      = "own"
+	BaseOpponent = "opponent"
+)
+
+var outcomeNames = map[int]string{OutcomeWin: "win", OutcomeDraw: "draw", OutcomeLoss: "loss"}
+
+// Condition matches a past round. Value is a move for SubjectOwn and SubjectOpponent and an
+// outcome for SubjectOutcome.
+type Condition struct {
+	Subject string `json:"subject"`
+	Lag     int    `json:"lag,omitempty"`
+	Value   int    `json:"value,omitempty"`
+}
+
+// Rule draws the next move from Weights when its condition matches.
+type Rule struct {
+	When    Condition `json:"when"`
+	Base    string    `json:"base"`
+	Weights [3]int    `json:"weights"`
+}
+
+// Program is a rule-based strategy: the first rule whose condition matches the recent history
+// draws the move, and a random move is played when none does.
+type Program struct {
+	Version int    `json:"version"`
+	Rules   []Rule `json:"rules"`
+}
+
+// Validate reports the first invalid rule of the program.
+func (r *Program) Validate() error {
+	if r.Version != ProgramVersion {
+		return fmt.Errorf("unsupported program version %d, want %d", r.Version, ProgramVersion)
+	}
+	for i, rule := range r.Rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (r Rule) validate() error {
+	switch r.When.Subject {
+	case SubjectAlways:
+	case SubjectOwn, SubjectOpponent:
+		if !model.Move(r.When.Value).Valid() {
+			return fmt.Errorf("invalid move %d", r.When.Value)
+		}
+	case SubjectOutcome:
+		if _, ok := outcomeNames[r.When.Value]; !ok {
+			return fmt.Errorf("invalid outcome %d", r.When.Value)
+		}
+	default:
+		return fmt.Errorf("unknown subject %q", r.When.Subject)
+	}
+	if r.When.Subject != SubjectAlways && (r.When.Lag < 1 || r.When.Lag > MaxLag) {
+		return fmt.Errorf("lag must be between 1 and %d", MaxLag)
+	}
+	if r.Base != BaseAbsolute && r.Base != BaseOwn && r.Base != BaseOpponent {
+		return fmt.Errorf("unknown base %q", r.Base)
+	}
+	total := 0
+	for _, weight := range r.Weights {
+		if weight < 0 {
+			return errors.New("negative weight")
+		}
+		total += weight
+	}
+	if total == 0 {
+		return errors.New("weights are all zero")
+	}
+	return nil
+}
+
+// String describes the rule, e.g. "if opponent[-1] = Rock: play 0/1/0 of opponent[-1] (same/beats/beaten)".
+func (r Rule) String() string {
+	condition := "always"
+	switch r.When.Subject {
+	case SubjectOwn, SubjectOpponent:
+		condition = fmt.Sprintf("if %s[-%d] = %s", r.When.Subject, r.When.Lag, model.MoveToStr[model.Move(r.When.Value)])
+	case SubjectOutcome:
+		condition = fmt.Sprintf("if outcome[-%d] = %s", r.When.Lag, outcomeNames[r.When.Value])
+	}
+	weights := fmt.Sprintf("%d/%d/%d", r.Weights[0], r.Weights[1], r.Weights[2])
+	if r.Base == BaseAbsolute {
+		return fmt.Sprintf("%s: play %s (rock/paper/scissors)", condition, weights)
+	}
+	return fmt.Sprintf("%s: play %s of %s[-1] (same/beats/beaten)", condition, weights, r.Base)
+}
+
+// String describes the rules of the program, one per line.
+func (r *Program) String() string {
+	lines := make([]string, 0, len(r.Rules)+1)
+	for _, rule := range r.Rules {
+		lines = append(lines, rule.String())
+	}
+	return strings.Join(append(lines, "otherwise: play at random"), "\n")
+}
+
+// LoadProgram reads a program saved by SaveProgram.
+func LoadProgram(path string) (*Program, error) {
+	program := &Program{}
+	if err := storage.LoadJSON(path, program); err != nil {
+		return nil, err
+	}
+	if err := program.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return program, nil
+}
+
+// SaveProgram writes the program to path.
+func SaveProgram(path string, program *Program) error {
+	return storage.SaveJSON(path, program)
+}
+
+// ProgramStrategy plays a rule program.
+type ProgramStrategy struct {
+	random  model.Randomizer
+	program *Program
+	// own and opponent hold the moves of the last rounds, the latest first.
+	own      [MaxLag]model.Move
+	opponent [MaxLag]model.Move
+}
+
+func NewProgramStrategy(random model.Randomizer, program *Program) *ProgramStrategy {
+	return &ProgramStrategy{random: random, program: program}
+}
+
+func (r *ProgramStrategy) Name() string {
+	return "evolved"
+}
+
+func (r *ProgramStrategy) Next() model.Move {
+	for _, rule := range r.program.Rules {
+		if !r.matches(rule) {
+			continue
+		}
+		move := weightedMove(r.random, rule.Weights, rule.Weights[0]+rule.Weights[1]+rule.Weights[2])
+		switch rule.Base {
+		case BaseOwn:
+			return relativeMove(r.own[0], move)
+		case BaseOpponent:
+			return relativeMove(r.opponent[0], move)
+		}
+		return move
+	}
+	return randomMove(r.random)
+}
+
+// matches reports whether the rule condition holds and the move its distribution is based on is known.
+func (r *ProgramStrategy) matches(rule Rule) bool {
+	if (rule.Base == BaseOwn || rule.Base == BaseOpponent) && !r.own[0].Valid() {
+		return false
+	}
+	if rule.When.Subject == SubjectAlways {
+		return true
+	}
+	own, opponent := r.own[rule.When.Lag-1], r.opponent[rule.When.Lag-1]
+	if !own.Valid() {
+		return false
+	}
+	switch rule.When.Subject {
+	case SubjectOwn:
+		return int(own) == rule.When.Value
+	case SubjectOpponent:
+		return int(opponent) == rule.When.Value
+	}
+	switch {
+	case model.Beats(own, opponent):
+		return rule.When.Value == OutcomeWin
+	case model.Beats(opponent, own):
+		return rule.When.Value == OutcomeLoss
+	}
+	return rule.When.Value == OutcomeDraw
+}
+
+// relativeMove maps a move drawn from same/beats/beaten weights (rock, paper and scissors
+// standing for each of them) to the actual move relative to base.
+func relativeMove(base, drawn model.Move) model.Move {
+	switch drawn {
+	case model.Paper:
+		return model.Counter(base)
+	case model.Scissors:
+		return model.Counter(model.Counter(base))
+	}
+	return base
+}
+
+func (r *ProgramStrategy) Observe(own, opponent model.Move) {
+	copy(r.own[1:], r.own[:MaxLag-1])
+	copy(r.opponent[1:], r.opponent[:MaxLag-1])
+	r.own[0], r.opponent[0] = own, opponent
+}
+
+func (r *ProgramStrategy) Reset() {
+	r.own = [MaxLag]model.Move{}
+	r.opponent = [MaxLag]model.Move{}
+}
+
+// loadDefaultProgram reads the program exported by the evolve command, or the file given as parameter.
+func loadDefaultProgram(params string) (*Program, error) {
+	path := params
+	if path == "" {
+		var err error
+		if path, err = storage.Path(ProgramFile); err != nil {
+			return nil, err
+		}
+	}
+	program, err := LoadProgram(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no evolved program at %s, run the evolve command first", path)
+	}
+	return program, err
+}
@@ -0,0 +1,103 @@
+package strategies
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestProgramStrategy(t *testing.T) {
+	program := &Program{Version: ProgramVersion, Rules: []Rule{
+		// after a loss, play what beats the opponent's last move
+		{When: Condition{Subject: SubjectOutcome, Lag: 1, Value: OutcomeLoss}, Base: BaseOpponent, Weights: [3]int{0, 1, 0}},
+		// when the opponent played rock two rounds ago, repeat the own last move
+		{When: Condition{Subject: SubjectOpponent, Lag: 2, Value: int(model.Rock)}, Base: BaseOwn, Weights: [3]int{1, 0, 0}},
+		{When: Condition{Subject: SubjectAlways}, Base: BaseAbsolute, Weights: [3]int{0, 0, 1}},
+	}}
+	assert.NoError(t, program.Validate())
+
+	s := NewProgramStrategy(&model.RandomizerMock{IntnFunc: func(n int) int { return 0 }}, program)
+	assert.Equal(t, "evolved", s.Name())
+	assert.Equal(t, model.Scissors, s.Next(), "only the always rule matches the first round")
+
+	s.Observe(model.Scissors, model.Rock)
+	assert.Equal(t, model.Paper, s.Next(), "paper beats the rock that won")
+
+	s.Observe(model.Paper, model.Paper)
+	assert.Equal(t, model.Paper, s.Next(), "the opponent played rock two rounds ago")
+
+	s.Observe(model.Paper, model.Rock)
+	assert.Equal(t, model.Scissors, s.Next())
+
+	s.Reset()
+	assert.Equal(t, model.Scissors, s.Next())
+}
+
+func Test_relativeMove(t *testing.T) {
+	assert.Equal(t, model.Rock, relativeMove(model.Rock, model.Rock), "same")
+	assert.Equal(t, model.Paper, relativeMove(model.Rock, model.Paper), "beats it")
+	assert.Equal(t, model.Scissors, relativeMove(model.Rock, model.Scissors), "it beats")
+}
+
+func TestProgram_Validate(t *testing.T) {
+	valid := Rule{When: Condition{Subject: SubjectOwn, Lag: 1, Value: int(model.Rock)}, Base: BaseAbsolute, Weights: [3]int{1, 0, 0}}
+	tests := []struct {
+		name   string
+		change func(p *Program)
+	}{
+		{"unsupported version", func(p *Program) { p.Version = 99 }},
+		{"unknown subject", func(p *Program) { p.Rules[0].When.Subject = "weather" }},
+		{"invalid move", func(p *Program) { p.Rules[0].When.Value = 4 }},
+		{"invalid outcome", func(p *Program) { p.Rules[0].When = Condition{Subject: SubjectOutcome, Lag: 1, Value: 0} }},
+		{"lag too old", func(p *Program) { p.Rules[0].When.Lag = MaxLag + 1 }},
+		{"unknown base", func(p *Program) { p.Rules[0].Base = "random" }},
+		{"negative weight", func(p *Program) { p.Rules[0].Weights = [3]int{-1, 2, 0} }},
+		{"zero weights", func(p *Program) { p.Rules[0].Weights = [3]int{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			program := &Program{Version: ProgramVersion, Rules: []Rule{valid}}
+			assert.NoError(t, program.Validate())
+			tt.change(program)
+			assert.Error(t, program.Validate())
+		})
+	}
+}
+
+func TestProgram_String(t *testing.T) {
+	program := &Program{Version: ProgramVersion, Rules: []Rule{
+		{When: Condition{Subject: SubjectOpponent, Lag: 1, Value: int(model.Rock)}, Base: BaseOpponent, Weights: [3]int{0, 1, 0}},
+		{When: Condition{Subject: SubjectOutcome, Lag: 2, Value: OutcomeDraw}, Base: BaseAbsolute, Weights: [3]int{1, 2, 3}},
+	}}
+	assert.Equal(t, "if opponent[-1] = Rock: play 0/1/0 of opponent[-1] (same/beats/beaten)\n"+
+		"if outcome[-2] = draw: play 1/2/3 (rock/paper/scissors)\n"+
+		"otherwise: play at random", program.String())
+}
+
+func TestLoadProgram(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "evolved.json")
+	program := &Program{Version: ProgramVersion, Rules: []Rule{
+		{When: Condition{Subject: SubjectAlways}, Base: BaseOwn, Weights: [3]int{1, 2, 3}},
+	}}
+	assert.NoError(t, SaveProgram(path, program))
+	loaded, err := LoadProgram(path)
+	assert.NoError(t, err)
+	assert.Equal(t, program, loaded)
+
+	invalid := filepath.Join(dir, "invalid.json")
+	assert.NoError(t, os.WriteFile(invalid, []byte(`{"version": 1, "rules": [{"when": {"subject": "weather"}}]}`), 0o644))
+	_, err = LoadProgram(invalid)
+	assert.ErrorContains(t, err, "rule 1")
+
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	_, err = New("evolved", &model.RandomizerMock{})
+	assert.ErrorContains(t, err, "run the evolve command first")
+	s, err := New("evolved:"+path, &model.RandomizerMock{})
+	assert.NoError(t, err)
+	assert.Equal(t, "evolved", s.Name())
+}
@@ -0,0 +1,151 @@
+package strategies
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+// QTableVersion is the version of the Q-table file format.
+const QTableVersion = 1
+
+// QTableFile is the default Q-table file, inside the data directory.
+const QTableFile = "qtable.json"
+
+// QTable holds the learned value of every move in every state of a QLearning strategy.
+type QTable struct {
+	Version int `json:"version"`
+	// Memory is the number of previous rounds a state is made of.
+	Memory int `json:"memory"`
+	// Values holds the rock, paper and scissors values of each state. A state encodes the last
+	// rounds as base-10 digits, 0 for a round not played yet and 1-9 for the pair of moves,
+	// which also determines the outcome.
+	Values [][3]float64 `json:"values"`
+}
+
+// NewQTable creates an untrained table whose states are made of the last memory rounds.
+func NewQTable(memory int) *QTable {
+	size := 1
+	for i := 0; i < memory; i++ {
+		size *= 10
+	}
+	return &QTable{Version: QTableVersion, Memory: memory, Values: make([][3]float64, size)}
+}
+
+// LoadQTable reads a table saved by SaveQTable.
+func LoadQTable(path string) (*QTable, error) {
+	table := &QTable{}
+	if err := storage.LoadJSON(path, table); err != nil {
+		return nil, err
+	}
+	if table.Version != QTableVersion {
+		return nil, fmt.Errorf("%s: unsupported Q-table version %d, want %d", path, table.Version, QTableVersion)
+	}
+	if len(table.Values) != len(NewQTable(table.Memory).Values) {
+		return nil, fmt.Errorf("%s: Q-table has %d states for a memory of %d rounds", path, len(table.Values), table.Memory)
+	}
+	return table, nil
+}
+
+// SaveQTable writes the table to path.
+func SaveQTable(path string, table *QTable) error {
+	return storage.SaveJSON(path, table)
+}
+
+// QLearning plays the best valued move of the current state, or a random move with the exploration
+// probability. While learning, it updates the values from the outcome of every round.
+type QLearning struct {
+	random model.Randomizer
+	table  *QTable
+	// Alpha is the learning rate, Gamma the discount of future rewards and Epsilon the exploration
+	// probability.
+	Alpha, Gamma, Epsilon float64
+	learning              bool
+	state                 int
+}
+
+func NewQLearning(random model.Randomizer, table *QTable) *QLearning {
+	return &QLearning{random: random, table: table}
+}
+
+// Learn enables learning with the given learning rate, discount and exploration probability.
+func (r *QLearning) Learn(alpha, gamma, epsilon float64) {
+	r.learning = true
+	r.Alpha, r.Gamma, r.Epsilon = alpha, gamma, epsilon
+}
+
+func (r *QLearning) Name() string {
+	return "qlearning"
+}
+
+func (r *QLearning) Next() model.Move {
+	if r.Epsilon > 0 && chance(r.random, r.Epsilon) {
+		return randomMove(r.random)
+	}
+	return model.Moves[r.best(r.state)]
+}
+
+func (r *QLearning) Observe(own, opponent model.Move) {
+	next := (r.state*10 + int(own-1)*3 + int(opponent-1) + 1) % len(r.table.Values)
+	if r.learning {
+		reward := 0.0
+		switch {
+		case model.Beats(own, opponent):
+			reward = 1
+		case model.Beats(opponent, own):
+			reward = -1
+		}
+		values := &r.table.Values[r.state]
+		future := r.table.Values[next][r.best(next)]
+		values[own-1] += r.Alpha * (reward + r.Gamma*future - values[own-1])
+	}
+	r.state = next
+}
+
+func (r *QLearning) Reset() {
+	r.state = 0
+}
+
+// best returns the index of the best valued move of the state, the first one on ties.
+func (r *QLearning) best(state int) int {
+	values := r.table.Values[state]
+	best := 0
+	for i := range values {
+		if values[i] > values[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+// LoadTrained returns the qlearning strategy with the table trained in the data directory, or nil
+// when no table was trained yet, so the computer can fall back to its heuristic.
+func LoadTrained(random model.Randomizer) (model.Strategy, error) {
+	table, err := loadDefaultQTable("")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewQLearning(random, table), nil
+}
+
+// loadDefaultQTable reads the table trained with the train command, or the file given as parameter.
+func loadDefaultQTable(params string) (*QTable, error) {
+	path := params
+	if path == "" {
+		var err error
+		if path, err = storage.Path(QTableFile); err != nil {
+			return nil, err
+		}
+	}
+	table, err := LoadQTable(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no trained Q-table at %s, run the train command first: %w", path, err)
+	}
+	return table, err
+}
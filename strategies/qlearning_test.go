@@ -0,0 +1,99 @@
+package strategies
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/storage"
+)
+
+func TestQLearning(t *testing.T) {
+	table := NewQTable(1)
+	assert.Len(t, table.Values, 10)
+	table.Values[0] = [3]float64{0, 1, 0}
+	// after a round where it played paper against rock, scissors is the best move
+	table.Values[4] = [3]float64{0, 0, 2}
+
+	q := NewQLearning(&model.RandomizerMock{}, table)
+	assert.Equal(t, "qlearning", q.Name())
+	assert.Equal(t, model.Paper, q.Next())
+	q.Observe(model.Paper, model.Rock)
+	assert.Equal(t, model.Scissors, q.Next())
+	q.Reset()
+	assert.Equal(t, model.Paper, q.Next())
+}
+
+func TestQLearning_Learn(t *testing.T) {
+	table := NewQTable(0)
+	q := NewQLearning(&model.RandomizerMock{}, table)
+	q.Learn(0.5, 0, 0)
+
+	q.Observe(model.Paper, model.Rock)
+	assert.Equal(t, [3]float64{0, 0.5, 0}, table.Values[0])
+	q.Observe(model.Rock, model.Paper)
+	assert.Equal(t, [3]float64{-0.5, 0.5, 0}, table.Values[0])
+	assert.Equal(t, model.Paper, q.Next())
+}
+
+func TestLoadQTable(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "qtable.json")
+	table := NewQTable(2)
+	table.Values[42] = [3]float64{1, 2, 3}
+	assert.NoError(t, SaveQTable(path, table))
+
+	loaded, err := LoadQTable(path)
+	assert.NoError(t, err)
+	assert.Equal(t, table, loaded)
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"unsupported version", `{"version": 99, "memory": 0, "values": [[0, 0, 0]]}`},
+		{"states not matching the memory", `{"version": 1, "memory": 1, "values": [[0, 0, 0]]}`},
+		{"malformed", `{"version": `},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invalid := filepath.Join(dir, "invalid.json")
+			assert.NoError(t, os.WriteFile(invalid, []byte(tt.content), 0o644))
+			_, err := LoadQTable(invalid)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNew_qlearning(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	_, err := New("qlearning", &model.RandomizerMock{})
+	assert.ErrorContains(t, err, "run the train command first")
+
+	path := filepath.Join(t.TempDir(), "table.json")
+	assert.NoError(t, SaveQTable(path, NewQTable(1)))
+	s, err := New("qlearning:"+path, &model.RandomizerMock{})
+	assert.NoError(t, err)
+	assert.Equal(t, "qlearning", s.Name())
+}
+
+func TestLoadTrained(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	s, err := LoadTrained(&model.RandomizerMock{})
+	assert.NoError(t, err)
+	assert.Nil(t, s, "no table trained yet")
+
+	path, err := storage.Path(QTableFile)
+	assert.NoError(t, err)
+	assert.NoError(t, SaveQTable(path, NewQTable(1)))
+	s, err = LoadTrained(&model.RandomizerMock{})
+	assert.NoError(t, err)
+	assert.Equal(t, "qlearning", s.Name())
+
+	assert.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+	_, err = LoadTrained(&model.RandomizerMock{})
+	assert.Error(t, err, "a broken table is reported")
+}
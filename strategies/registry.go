@@ -0,0 +1,246 @@
+package strategies
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/opponents"
+)
+
+// Factory creates a strategy from the parameters written after the colon of its spec, e.g. "biased:5,3,2".
+type Factory func(random model.Randomizer, params string) (model.Strategy, error)
+
+// Entry is a registered strategy.
+type Entry struct {
+	Name        string
+	Description string
+	Factory     Factory
+	// Trained strategies load a file written by a command, so they are left out of Zoo.
+	Trained bool
+}
+
+var registry = map[string]Entry{}
+
+// register adds a strategy to the registry, replacing any strategy with the same name.
+func register(entry Entry) {
+	registry[entry.Name] = entry
+}
+
+func init() {
+	register(Entry{
+		Name:        "computer",
+		Description: "the default computer heuristic: plays the move missing from the last round",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			return NewHeuristic(random), noParams(params)
+		},
+	})
+	register(Entry{
+		Name:        "rock",
+		Description: "always plays rock",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			return NewConstant("rock", model.Rock), noParams(params)
+		},
+	})
+	register(Entry{
+		Name:        "cycler",
+		Description: "plays rock, paper and scissors in turn",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			return NewCycler(), noParams(params)
+		},
+	})
+	register(Entry{
+		Name:        "copycat",
+		Description: "plays the opponent's last move",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			return NewCopycat(random), noParams(params)
+		},
+	})
+	register(Entry{
+		Name:        "anti-copycat",
+		Description: "plays what beats its own last move",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			return NewAntiCopycat(random), noParams(params)
+		},
+	})
+	register(Entry{
+		Name:        "biased",
+		Description: "plays at random with rock,paper,scissors weights (default biased:5,3,2)",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			weights, err := parseWeights(params, [3]int{5, 3, 2})
+			if err != nil {
+				return nil, err
+			}
+			return NewBiasedRandom(random, weights), nil
+		},
+	})
+	register(Entry{
+		Name:        "debruijn",
+		Description: "cycles through a de Bruijn sequence of the given order (default debruijn:2)",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			order, err := parseInt(params, 2)
+			if err != nil || order < 1 || order > 8 {
+				return nil, fmt.Errorf("invalid de Bruijn order %q", params)
+			}
+			return NewDeBruijn(order), nil
+		},
+	})
+	register(Entry{
+		Name:        "wsls",
+		Description: "win-stay/lose-shift with a noise probability (default wsls:0.1)",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			noise, err := parseProbability(params, 0.1)
+			if err != nil {
+				return nil, err
+			}
+			return NewWinStayLoseShift(random, noise), nil
+		},
+	})
+	register(Entry{
+		Name:        "human",
+		Description: "synthetic human with rock=,stay=,shift=,triple= bias strengths (default human:rock=0.5,stay=0.6,shift=0.6,triple=0.8)",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			biases, err := parseBiases(params)
+			if err != nil {
+				return nil, err
+			}
+			return NewSimulatedHuman(random, biases), nil
+		},
+	})
+	register(Entry{
+		Name:        "qlearning",
+		Description: "Q-learning table trained with the train command (qlearning:<file> loads another table)",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			table, err := loadDefaultQTable(params)
+			if err != nil {
+				return nil, err
+			}
+			return NewQLearning(random, table), nil
+		},
+		Trained: true,
+	})
+	register(Entry{
+		Name:        "evolved",
+		Description: "rule program exported by the evolve command (evolved:<file> loads another program)",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			program, err := loadDefaultProgram(params)
+			if err != nil {
+				return nil, err
+			}
+			return NewProgramStrategy(random, program), nil
+		},
+		Trained: true,
+	})
+}
+
+// RegisterGhost registers the ghost strategy, which imitates the players recorded in the match store,
+// e.g. ghost:ANA. Without it, ghost specs are unknown.
+func RegisterGhost(store model.MatchStore) {
+	register(Entry{
+		Name:        "ghost",
+		Description: "imitates the recorded moves of a player, e.g. ghost:ANA",
+		Factory: func(random model.Randomizer, params string) (model.Strategy, error) {
+			matches, err := store.Matches()
+			if err != nil {
+				return nil, err
+			}
+			profile := opponents.FromMatches(strings.ToUpper(params), matches)
+			if profile.Rounds() == 0 {
+				return nil, fmt.Errorf("no recorded rounds for player %q", params)
+			}
+			return opponents.NewGhost(random, profile), nil
+		},
+		Trained: true,
+	})
+}
+
+// New creates the strategy described by spec, a registered name optionally followed by ":params".
+func New(spec string, random model.Randomizer) (model.Strategy, error) {
+	name, params, _ := strings.Cut(spec, ":")
+	entry, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %q (available: %s)", name, strings.Join(Names(), ", "))
+	}
+	return entry.Factory(random, params)
+}
+
+// Names returns the registered strategy names in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Zoo returns the names of the strategies that need no training, in alphabetical order.
+func Zoo() []string {
+	names := make([]string, 0, len(registry))
+	for _, name := range Names() {
+		if !registry[name].Trained {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Entries returns the registered strategies in alphabetical order.
+func Entries() []Entry {
+	entries := make([]Entry, 0, len(registry))
+	for _, name := range Names() {
+		entries = append(entries, registry[name])
+	}
+	return entries
+}
+
+func noParams(params string) error {
+	if params != "" {
+		return fmt.Errorf("unexpected parameters %q", params)
+	}
+	return nil
+}
+
+func parseInt(params string, fallback int) (int, error) {
+	if params == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(params)
+}
+
+func parseProbability(params string, fallback float64) (float64, error) {
+	if params == "" {
+		return fallback, nil
+	}
+	p, err := strconv.ParseFloat(params, 64)
+	if err != nil || p < 0 || p > 1 {
+		return 0, fmt.Errorf("invalid probability %q", params)
+	}
+	return p, nil
+}
+
+func parseWeights(params string, fallback [3]int) ([3]int, error) {
+	if params == "" {
+		return fallback, nil
+	}
+	weights := [3]int{}
+	fields := strings.Split(params, ",")
+	if len(fields) != len(weights) {
+		return weights, fmt.Errorf("invalid weights %q, want rock,paper,scissors", params)
+	}
+	total := 0
+	for i, field := range fields {
+		w, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || w < 0 {
+			return weights, fmt.Errorf("invalid weight %q", field)
+		}
+		weights[i] = w
+		total += w
+	}
+	if total == 0 {
+		return weights, fmt.Errorf("weights %q are all zero", params)
+	}
+	return weights, nil
+}
@@ -0,0 +1,85 @@
+package strategies
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantName string
+		wantErr  bool
+	}{
+		{"plain name", "copycat", "copycat", false},
+		{"biased with weights", "biased:1,1,8", "biased:1,1,8", false},
+		{"biased with the default weights", "biased", "biased:5,3,2", false},
+		{"de Bruijn with order", "debruijn:3", "debruijn", false},
+		{"wsls with noise", "wsls:0.25", "wsls", false},
+		{"simulated human with biases", "human:rock=1,shift=0", "human", false},
+		{"unknown strategy", "lizard", "", true},
+		{"parameters on a strategy without any", "rock:1", "", true},
+		{"invalid weights", "biased:1,2", "", true},
+		{"zero weights", "biased:0,0,0", "", true},
+		{"invalid order", "debruijn:0", "", true},
+		{"invalid noise", "wsls:2", "", true},
+		{"invalid bias", "human:rock", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.spec, rand.New(rand.NewSource(1)))
+			assert.Equal(t, tt.wantErr, err != nil, "error: %v", err)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantName, s.Name())
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	names := Names()
+	assert.IsIncreasing(t, names)
+	for _, name := range []string{"rock", "cycler", "copycat", "anti-copycat", "biased", "debruijn", "wsls", "human", "computer"} {
+		assert.Contains(t, names, name)
+	}
+	assert.Len(t, Entries(), len(names))
+}
+
+// matchStore is a match store reading its matches from a function.
+type matchStore func() ([]model.Match, error)
+
+func (r matchStore) Append(match model.Match) error { return nil }
+
+func (r matchStore) Matches() ([]model.Match, error) { return r() }
+
+func TestNew_ghost(t *testing.T) {
+	delete(registry, "ghost")
+	_, err := New("ghost:ana", rand.New(rand.NewSource(1)))
+	assert.ErrorContains(t, err, "unknown strategy", "the ghost needs a match store")
+
+	matches := []model.Match{}
+	var readErr error
+	RegisterGhost(matchStore(func() ([]model.Match, error) { return matches, readErr }))
+	defer delete(registry, "ghost")
+	_, err = New("ghost:ana", rand.New(rand.NewSource(1)))
+	assert.ErrorContains(t, err, "no recorded rounds")
+
+	matches = append(matches, model.Match{
+		Players: [2]string{"ROBOT", "ANA"},
+		Rounds:  []model.MatchRound{{Moves: [2]model.Move{model.Paper, model.Rock}}},
+	})
+	s, err := New("ghost:ana", rand.New(rand.NewSource(1)))
+	assert.NoError(t, err)
+	assert.Equal(t, "ghost:ANA", s.Name())
+	assert.Equal(t, model.Rock, s.Next())
+
+	readErr = errors.New("locked")
+	_, err = New("ghost:ana", rand.New(rand.NewSource(1)))
+	assert.ErrorContains(t, err, "locked")
+}
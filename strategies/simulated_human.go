@@ -0,0 +1,115 @@
+package strategies
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// Biases configures the habits of a SimulatedHuman. Each strength is the probability, between 0 and 1,
+// that the habit drives the move when it applies; otherwise the move is random.
+type Biases struct {
+	// RockOpener is the chance of opening a game with rock.
+	RockOpener float64
+	// WinStay is the chance of repeating a winning move.
+	WinStay float64
+	// LoseShift is the chance of switching, after a loss, to the move that would have beaten the winner.
+	LoseShift float64
+	// AvoidTriple is the chance of refusing to play the same move three times in a row.
+	AvoidTriple float64
+}
+
+// DefaultBiases are moderate habits reported for casual players.
+var DefaultBiases = Biases{
+	RockOpener:  0.5,
+	WinStay:     0.6,
+	LoseShift:   0.6,
+	AvoidTriple: 0.8,
+}
+
+// SimulatedHuman is a synthetic player with known psychological biases, used to evaluate strategies.
+type SimulatedHuman struct {
+	random       model.Randomizer
+	biases       Biases
+	lastOwn      model.Move
+	previousOwn  model.Move
+	lastOpponent model.Move
+}
+
+func NewSimulatedHuman(random model.Randomizer, biases Biases) *SimulatedHuman {
+	return &SimulatedHuman{random: random, biases: biases}
+}
+
+func (r *SimulatedHuman) Name() string {
+	return "human"
+}
+
+func (r *SimulatedHuman) Next() model.Move {
+	move := r.habit()
+	if move == r.lastOwn && move == r.previousOwn && chance(r.random, r.biases.AvoidTriple) {
+		// picks one of the two other moves
+		move = model.Moves[(int(move)+r.random.Intn(2))%len(model.Moves)]
+	}
+	return move
+}
+
+// habit returns the move suggested by the first bias that applies, or a random one.
+func (r *SimulatedHuman) habit() model.Move {
+	switch {
+	case !r.lastOwn.Valid():
+		if chance(r.random, r.biases.RockOpener) {
+			return model.Rock
+		}
+	case model.Beats(r.lastOwn, r.lastOpponent):
+		if chance(r.random, r.biases.WinStay) {
+			return r.lastOwn
+		}
+	case model.Beats(r.lastOpponent, r.lastOwn):
+		if chance(r.random, r.biases.LoseShift) {
+			return model.Counter(r.lastOpponent)
+		}
+	}
+	return randomMove(r.random)
+}
+
+func (r *SimulatedHuman) Observe(own, opponent model.Move) {
+	r.previousOwn = r.lastOwn
+	r.lastOwn = own
+	r.lastOpponent = opponent
+}
+
+func (r *SimulatedHuman) Reset() {
+	r.lastOwn = 0
+	r.previousOwn = 0
+	r.lastOpponent = 0
+}
+
+// parseBiases reads comma separated key=strength pairs, e.g. "rock=0.9,stay=0", over the defaults.
+// The keys are rock, stay, shift and triple.
+func parseBiases(params string) (Biases, error) {
+	biases := DefaultBiases
+	if params == "" {
+		return biases, nil
+	}
+	fields := map[string]*float64{
+		"rock":   &biases.RockOpener,
+		"stay":   &biases.WinStay,
+		"shift":  &biases.LoseShift,
+		"triple": &biases.AvoidTriple,
+	}
+	for _, pair := range strings.Split(params, ",") {
+		key, value, _ := strings.Cut(pair, "=")
+		field, ok := fields[strings.TrimSpace(key)]
+		if !ok {
+			return biases, fmt.Errorf("unknown bias %q (available: rock, stay, shift, triple)", key)
+		}
+		strength, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || strength < 0 || strength > 1 {
+			return biases, fmt.Errorf("invalid strength %q for bias %q", value, key)
+		}
+		*field = strength
+	}
+	return biases, nil
+}
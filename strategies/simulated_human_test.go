@@ -0,0 +1,132 @@
+package strategies
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+func TestSimulatedHuman_Next(t *testing.T) {
+	r, p, s := model.Rock, model.Paper, model.Scissors
+	none := Biases{}
+	tests := []struct {
+		name     string
+		biases   Biases
+		random   int // value returned by the randomizer, capped to the requested range
+		history  [][2]model.Move
+		wantMove model.Move
+	}{
+		{
+			name:     "rock opener",
+			biases:   Biases{RockOpener: 1},
+			random:   2,
+			wantMove: r,
+		},
+		{
+			name:     "opens at random without the bias",
+			biases:   none,
+			random:   2,
+			wantMove: s,
+		},
+		{
+			name:     "stays after a win",
+			biases:   Biases{WinStay: 1},
+			random:   0,
+			history:  [][2]model.Move{{s, p}},
+			wantMove: s,
+		},
+		{
+			name:     "shifts to what beats the winner after a loss",
+			biases:   Biases{LoseShift: 1},
+			random:   0,
+			history:  [][2]model.Move{{r, p}},
+			wantMove: s,
+		},
+		{
+			name:     "plays at random after a draw",
+			biases:   Biases{WinStay: 1, LoseShift: 1},
+			random:   1,
+			history:  [][2]model.Move{{r, r}},
+			wantMove: p,
+		},
+		{
+			name:     "avoids a third identical move",
+			biases:   Biases{WinStay: 1, AvoidTriple: 1},
+			random:   0,
+			history:  [][2]model.Move{{p, r}, {p, r}},
+			wantMove: s,
+		},
+		{
+			name:     "plays a third identical move without the bias",
+			biases:   Biases{WinStay: 1},
+			random:   0,
+			history:  [][2]model.Move{{p, r}, {p, r}},
+			wantMove: p,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewSimulatedHuman(fixedRandomizer(tt.random), tt.biases)
+			for _, round := range tt.history {
+				h.Observe(round[0], round[1])
+			}
+			assert.Equal(t, tt.wantMove, h.Next())
+		})
+	}
+}
+
+func TestSimulatedHuman_strength(t *testing.T) {
+	tests := []struct {
+		name     string
+		strength float64
+		wantRate float64
+	}{
+		// a random opening is rock a third of the time
+		{"no bias", 0, 1.0 / 3},
+		{"half strength", 0.5, 0.5 + 0.5/3},
+		{"full strength", 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewSimulatedHuman(rand.New(rand.NewSource(7)), Biases{RockOpener: tt.strength})
+			rocks := 0
+			for i := 0; i < 10000; i++ {
+				h.Reset()
+				if h.Next() == model.Rock {
+					rocks++
+				}
+			}
+			assert.InDelta(t, tt.wantRate, float64(rocks)/10000, 0.02)
+		})
+	}
+}
+
+func TestParseBiases(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		want    Biases
+		wantErr bool
+	}{
+		{"defaults", "", DefaultBiases, false},
+		{
+			name:   "overrides some biases",
+			params: "rock=0.9, triple=0",
+			want:   Biases{RockOpener: 0.9, WinStay: 0.6, LoseShift: 0.6, AvoidTriple: 0},
+		},
+		{"unknown bias", "lizard=1", Biases{}, true},
+		{"strength out of range", "stay=1.5", Biases{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBiases(tt.params)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
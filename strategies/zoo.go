@@ -0,0 +1,257 @@
+// Package strategies implements the automated ways of choosing moves, from simple sparring bots to learning ones.
+package strategies
+
+import (
+	"fmt"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// randomMove returns one of the ruleset moves with uniform probability.
+func randomMove(random model.Randomizer) model.Move {
+	return model.Moves[random.Intn(len(model.Moves))]
+}
+
+// weightedMove draws rock, paper or scissors with the given weights, whose sum is total.
+func weightedMove(random model.Randomizer, weights [3]int, total int) model.Move {
+	n := random.Intn(total)
+	for i, weight := range weights {
+		if n < weight {
+			return model.Moves[i]
+		}
+		n -= weight
+	}
+	return model.Moves[len(model.Moves)-1]
+}
+
+// chance returns true with probability p.
+func chance(random model.Randomizer, p float64) bool {
+	return random.Intn(1000) < int(p*1000)
+}
+
+// Constant always plays the same move.
+type Constant struct {
+	name string
+	move model.Move
+}
+
+func NewConstant(name string, move model.Move) *Constant {
+	return &Constant{name: name, move: move}
+}
+
+func (r *Constant) Name() string {
+	return r.name
+}
+
+func (r *Constant) Next() model.Move {
+	return r.move
+}
+
+func (r *Constant) Observe(own, opponent model.Move) {}
+
+func (r *Constant) Reset() {}
+
+// Cycler plays rock, paper and scissors in turn.
+type Cycler struct {
+	next int
+}
+
+func NewCycler() *Cycler {
+	return &Cycler{}
+}
+
+func (r *Cycler) Name() string {
+	return "cycler"
+}
+
+func (r *Cycler) Next() model.Move {
+	move := model.Moves[r.next]
+	r.next = (r.next + 1) % len(model.Moves)
+	return move
+}
+
+func (r *Cycler) Observe(own, opponent model.Move) {}
+
+func (r *Cycler) Reset() {
+	r.next = 0
+}
+
+// Copycat plays the last move of its opponent, opening at random.
+type Copycat struct {
+	random       model.Randomizer
+	lastOpponent model.Move
+}
+
+func NewCopycat(random model.Randomizer) *Copycat {
+	return &Copycat{random: random}
+}
+
+func (r *Copycat) Name() string {
+	return "copycat"
+}
+
+func (r *Copycat) Next() model.Move {
+	if !r.lastOpponent.Valid() {
+		return randomMove(r.random)
+	}
+	return r.lastOpponent
+}
+
+func (r *Copycat) Observe(own, opponent model.Move) {
+	r.lastOpponent = opponent
+}
+
+func (r *Copycat) Reset() {
+	r.lastOpponent = 0
+}
+
+// AntiCopycat beats a copycat by playing what beats its own last move, opening at random.
+type AntiCopycat struct {
+	random  model.Randomizer
+	lastOwn model.Move
+}
+
+func NewAntiCopycat(random model.Randomizer) *AntiCopycat {
+	return &AntiCopycat{random: random}
+}
+
+func (r *AntiCopycat) Name() string {
+	return "anti-copycat"
+}
+
+func (r *AntiCopycat) Next() model.Move {
+	if !r.lastOwn.Valid() {
+		return randomMove(r.random)
+	}
+	return model.Counter(r.lastOwn)
+}
+
+func (r *AntiCopycat) Observe(own, opponent model.Move) {
+	r.lastOwn = own
+}
+
+func (r *AntiCopycat) Reset() {
+	r.lastOwn = 0
+}
+
+// BiasedRandom plays at random with a fixed weight for each move.
+type BiasedRandom struct {
+	random  model.Randomizer
+	weights [3]int
+	total   int
+}
+
+// NewBiasedRandom creates a biased random strategy; the weights of rock, paper and scissors must not all be zero.
+func NewBiasedRandom(random model.Randomizer, weights [3]int) *BiasedRandom {
+	return &BiasedRandom{
+		random:  random,
+		weights: weights,
+		total:   weights[0] + weights[1] + weights[2],
+	}
+}
+
+// Name includes the weights, so biased strategies with different weights are told apart, e.g. in the
+// ratings.
+func (r *BiasedRandom) Name() string {
+	return fmt.Sprintf("biased:%d,%d,%d", r.weights[0], r.weights[1], r.weights[2])
+}
+
+func (r *BiasedRandom) Next() model.Move {
+	return weightedMove(r.random, r.weights, r.total)
+}
+
+func (r *BiasedRandom) Observe(own, opponent model.Move) {}
+
+func (r *BiasedRandom) Reset() {}
+
+// DeBruijn cycles through a de Bruijn sequence, so every combination of order consecutive moves
+// is played exactly once per cycle.
+type DeBruijn struct {
+	sequence []model.Move
+	next     int
+}
+
+func NewDeBruijn(order int) *DeBruijn {
+	return &DeBruijn{sequence: deBruijnSequence(len(model.Moves), order)}
+}
+
+func (r *DeBruijn) Name() string {
+	return "debruijn"
+}
+
+func (r *DeBruijn) Next() model.Move {
+	move := r.sequence[r.next]
+	r.next = (r.next + 1) % len(r.sequence)
+	return move
+}
+
+func (r *DeBruijn) Observe(own, opponent model.Move) {}
+
+func (r *DeBruijn) Reset() {
+	r.next = 0
+}
+
+// deBruijnSequence generates the de Bruijn sequence B(k, n) with the standard Lyndon words algorithm.
+func deBruijnSequence(k, n int) []model.Move {
+	a := make([]int, k*n)
+	sequence := make([]model.Move, 0)
+	var db func(t, p int)
+	db = func(t, p int) {
+		if t > n {
+			if n%p == 0 {
+				for _, digit := range a[1 : p+1] {
+					sequence = append(sequence, model.Moves[digit])
+				}
+			}
+			return
+		}
+		a[t] = a[t-p]
+		db(t+1, p)
+		for j := a[t-p] + 1; j < k; j++ {
+			a[t] = j
+			db(t+1, t)
+		}
+	}
+	db(1, 1)
+	return sequence
+}
+
+// WinStayLoseShift mimics a common human habit: it repeats a winning move, switches to what beats
+// the opponent after a loss and plays at random after a draw. With probability noise it ignores the habit.
+type WinStayLoseShift struct {
+	random       model.Randomizer
+	noise        float64
+	lastOwn      model.Move
+	lastOpponent model.Move
+}
+
+func NewWinStayLoseShift(random model.Randomizer, noise float64) *WinStayLoseShift {
+	return &WinStayLoseShift{random: random, noise: noise}
+}
+
+func (r *WinStayLoseShift) Name() string {
+	return "wsls"
+}
+
+func (r *WinStayLoseShift) Next() model.Move {
+	if chance(r.random, r.noise) {
+		return randomMove(r.random)
+	}
+	switch {
+	case model.Beats(r.lastOwn, r.lastOpponent):
+		return r.lastOwn
+	case model.Beats(r.lastOpponent, r.lastOwn):
+		return model.Counter(r.lastOpponent)
+	}
+	return randomMove(r.random)
+}
+
+func (r *WinStayLoseShift) Observe(own, opponent model.Move) {
+	r.lastOwn = own
+	r.lastOpponent = opponent
+}
+
+func (r *WinStayLoseShift) Reset() {
+	r.lastOwn = 0
+	r.lastOpponent = 0
+}
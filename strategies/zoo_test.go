@@ -0,0 +1,146 @@
+package strategies
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+)
+
+// play feeds the opponent moves to the strategy and returns the moves it played.
+func play(s model.Strategy, opponent []model.Move) []model.Move {
+	moves := make([]model.Move, 0, len(opponent))
+	for _, o := range opponent {
+		m := s.Next()
+		moves = append(moves, m)
+		s.Observe(m, o)
+	}
+	return moves
+}
+
+// fixedRandomizer always returns n, capped to the requested range.
+func fixedRandomizer(n int) model.Randomizer {
+	return &model.RandomizerMock{IntnFunc: func(max int) int { return n % max }}
+}
+
+func TestZoo(t *testing.T) {
+	r, p, s := model.Rock, model.Paper, model.Scissors
+	tests := []struct {
+		name     string
+		strategy model.Strategy
+		opponent []model.Move
+		want     []model.Move
+	}{
+		{
+			name:     "constant rock",
+			strategy: NewConstant("rock", r),
+			opponent: []model.Move{p, s, r},
+			want:     []model.Move{r, r, r},
+		},
+		{
+			name:     "computer heuristic",
+			strategy: NewHeuristic(fixedRandomizer(1)),
+			// opens at random, then plays the move missing from the last round unless it was a draw
+			opponent: []model.Move{r, p, s, p, r},
+			want:     []model.Move{p, s, r, p, p},
+		},
+		{
+			name:     "cycler",
+			strategy: NewCycler(),
+			opponent: []model.Move{p, p, p, p},
+			want:     []model.Move{r, p, s, r},
+		},
+		{
+			name:     "copycat opens at random then copies",
+			strategy: NewCopycat(fixedRandomizer(2)),
+			opponent: []model.Move{p, s, r},
+			want:     []model.Move{s, p, s},
+		},
+		{
+			name:     "anti-copycat beats its own last move",
+			strategy: NewAntiCopycat(fixedRandomizer(0)),
+			opponent: []model.Move{p, s, r},
+			want:     []model.Move{r, p, s},
+		},
+		{
+			name:     "biased random with a single non zero weight",
+			strategy: NewBiasedRandom(fixedRandomizer(7), [3]int{0, 0, 4}),
+			opponent: []model.Move{r, r},
+			want:     []model.Move{s, s},
+		},
+		{
+			name:     "de Bruijn of order 2",
+			strategy: NewDeBruijn(2),
+			opponent: []model.Move{r, r, r, r, r, r, r, r, r, r},
+			want:     []model.Move{r, r, p, r, s, p, p, s, s, r},
+		},
+		{
+			name:     "win-stay lose-shift without noise",
+			strategy: NewWinStayLoseShift(fixedRandomizer(999), 0),
+			// opens at random, wins and stays, loses twice and shifts to what beats the opponent,
+			// then plays at random after the draw
+			opponent: []model.Move{s, p, r, p, s},
+			want:     []model.Move{r, r, s, p, r},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, play(tt.strategy, tt.opponent))
+
+			tt.strategy.Reset()
+			assert.Equal(t, tt.want[0], tt.strategy.Next(), "after Reset the strategy starts over")
+		})
+	}
+}
+
+func TestBiasedRandom_Next(t *testing.T) {
+	b := NewBiasedRandom(rand.New(rand.NewSource(1)), [3]int{6, 3, 1})
+	counts := map[model.Move]int{}
+	for i := 0; i < 10000; i++ {
+		counts[b.Next()]++
+	}
+	assert.InDelta(t, 6000, counts[model.Rock], 300)
+	assert.InDelta(t, 3000, counts[model.Paper], 300)
+	assert.InDelta(t, 1000, counts[model.Scissors], 300)
+}
+
+func TestWinStayLoseShift_noise(t *testing.T) {
+	// with full noise the habit is ignored and every move comes from the randomizer
+	w := NewWinStayLoseShift(fixedRandomizer(1), 1)
+	w.Observe(model.Rock, model.Scissors)
+	assert.Equal(t, model.Paper, w.Next())
+}
+
+func TestDeBruijnSequence(t *testing.T) {
+	tests := []struct {
+		name  string
+		order int
+	}{
+		{"order 1", 1},
+		{"order 2", 2},
+		{"order 3", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sequence := deBruijnSequence(3, tt.order)
+			combinations := 1
+			for i := 0; i < tt.order; i++ {
+				combinations *= 3
+			}
+			assert.Len(t, sequence, combinations)
+
+			// every window of order moves, wrapping around, is unique
+			seen := map[string]bool{}
+			for i := range sequence {
+				window := ""
+				for j := 0; j < tt.order; j++ {
+					window += model.MoveToStr[sequence[(i+j)%len(sequence)]]
+				}
+				assert.False(t, seen[window], "window %s repeated", window)
+				seen[window] = true
+			}
+		})
+	}
+}
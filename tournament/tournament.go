@@ -0,0 +1,184 @@
+// Package tournament runs round-robin tournaments between strategies.
+package tournament
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+// Points awarded for each pairing, based on who won more of its games.
+const (
+	PointsWin  = 3
+	PointsDraw = 1
+)
+
+// Config configures a tournament.
+type Config struct {
+	// Strategies are the participants; every strategy of strategies.Zoo when empty.
+	Strategies []string
+	// Games is the number of games played by each pairing.
+	Games        int
+	Seed         int64
+	WinningScore int
+	MaxRounds    int
+}
+
+// Standing is the tournament record of a strategy.
+type Standing struct {
+	Strategy  string
+	Points    int
+	Won       int
+	Drawn     int
+	Lost      int
+	GamesWon  int
+	GamesLost int
+}
+
+// Margin returns the difference between games won and lost.
+func (r Standing) Margin() int {
+	return r.GamesWon - r.GamesLost
+}
+
+// Result holds the standings and every head-to-head result.
+type Result struct {
+	Config Config
+	// Standings are sorted by points, then margin.
+	Standings []Standing
+	// headToHead[a][b] is the result of a playing as player 1 against b.
+	headToHead map[string]map[string]simulation.Result
+}
+
+// Run plays every strategy against every other one.
+func Run(cfg Config) (Result, error) {
+	if len(cfg.Strategies) == 0 {
+		cfg.Strategies = strategies.Zoo()
+	}
+	if len(cfg.Strategies) < 2 {
+		return Result{}, errors.New("a tournament needs at least two strategies")
+	}
+
+	result := Result{Config: cfg, headToHead: map[string]map[string]simulation.Result{}}
+	standings := map[string]*Standing{}
+	for _, name := range cfg.Strategies {
+		if _, ok := standings[name]; ok {
+			// the standings are keyed by strategy, so a second entry would merge into the first
+			return Result{}, fmt.Errorf("strategy %q entered twice", name)
+		}
+		standings[name] = &Standing{Strategy: name}
+		result.headToHead[name] = map[string]simulation.Result{}
+	}
+
+	pairing := 0
+	for i, p1 := range cfg.Strategies {
+		for _, p2 := range cfg.Strategies[i+1:] {
+			matchup, err := simulation.Run(simulation.Config{
+				P1:           p1,
+				P2:           p2,
+				Games:        cfg.Games,
+				Seed:         cfg.Seed + int64(pairing),
+				WinningScore: cfg.WinningScore,
+				MaxRounds:    cfg.MaxRounds,
+			})
+			if err != nil {
+				return Result{}, fmt.Errorf("%s vs %s: %w", p1, p2, err)
+			}
+			pairing++
+
+			result.headToHead[p1][p2] = matchup
+			score(standings[p1], standings[p2], matchup.Games)
+		}
+	}
+
+	for _, name := range cfg.Strategies {
+		result.Standings = append(result.Standings, *standings[name])
+	}
+	sort.SliceStable(result.Standings, func(i, j int) bool {
+		a, b := result.Standings[i], result.Standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		return a.Margin() > b.Margin()
+	})
+	return result, nil
+}
+
+// score updates the standings of both strategies with the games of their pairing.
+func score(p1, p2 *Standing, games simulation.Outcome) {
+	p1.GamesWon += games.Wins
+	p1.GamesLost += games.Losses
+	p2.GamesWon += games.Losses
+	p2.GamesLost += games.Wins
+
+	switch {
+	case games.Wins > games.Losses:
+		p1.Won++
+		p1.Points += PointsWin
+		p2.Lost++
+	case games.Wins < games.Losses:
+		p2.Won++
+		p2.Points += PointsWin
+		p1.Lost++
+	default:
+		p1.Drawn++
+		p2.Drawn++
+		p1.Points += PointsDraw
+		p2.Points += PointsDraw
+	}
+}
+
+// WinRate returns the share of games a won against b.
+func (r Result) WinRate(a, b string) float64 {
+	if matchup, ok := r.headToHead[a][b]; ok {
+		rate, _ := matchup.WinRate()
+		return rate
+	}
+	if matchup, ok := r.headToHead[b][a]; ok && matchup.Games.Total() > 0 {
+		return float64(matchup.Games.Losses) / float64(matchup.Games.Total())
+	}
+	return 0
+}
+
+// WriteCSV exports the standings, with the win rate against every opponent, in standings order.
+func (r Result) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"rank", "strategy", "points", "won", "drawn", "lost", "games_won", "games_lost", "margin"}
+	for _, opponent := range r.Standings {
+		header = append(header, "vs_"+opponent.Strategy)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for i, s := range r.Standings {
+		record := []string{
+			strconv.Itoa(i + 1),
+			s.Strategy,
+			strconv.Itoa(s.Points),
+			strconv.Itoa(s.Won),
+			strconv.Itoa(s.Drawn),
+			strconv.Itoa(s.Lost),
+			strconv.Itoa(s.GamesWon),
+			strconv.Itoa(s.GamesLost),
+			strconv.Itoa(s.Margin()),
+		}
+		for _, opponent := range r.Standings {
+			rate := ""
+			if opponent.Strategy != s.Strategy {
+				rate = strconv.FormatFloat(r.WinRate(s.Strategy, opponent.Strategy), 'f', 4, 64)
+			}
+			record = append(record, rate)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
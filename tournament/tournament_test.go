@@ -0,0 +1,117 @@
+package tournament
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/simulation"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+func deterministicConfig() Config {
+	return Config{
+		Strategies:   []string{"rock", "cycler", "debruijn"},
+		Games:        5,
+		Seed:         1,
+		WinningScore: 3,
+		MaxRounds:    50,
+	}
+}
+
+func TestRun(t *testing.T) {
+	result, err := Run(deterministicConfig())
+	assert.NoError(t, err)
+	assert.Equal(t, []Standing{
+		{Strategy: "cycler", Points: 6, Won: 2, GamesWon: 10},
+		{Strategy: "debruijn", Points: 3, Won: 1, Lost: 1, GamesWon: 5, GamesLost: 5},
+		{Strategy: "rock", Points: 0, Lost: 2, GamesLost: 10},
+	}, result.Standings)
+	assert.Equal(t, 10, result.Standings[0].Margin())
+	assert.Equal(t, 1.0, result.WinRate("cycler", "rock"))
+	assert.Equal(t, 0.0, result.WinRate("rock", "cycler"))
+	assert.Equal(t, 0.0, result.WinRate("rock", "rock"))
+}
+
+func TestRun_allStrategies(t *testing.T) {
+	result, err := Run(Config{Games: 3, Seed: 1, WinningScore: 3, MaxRounds: 50})
+	assert.NoError(t, err)
+	assert.Len(t, result.Standings, len(strategies.Zoo()))
+
+	// every strategy meets every other once, and each pairing hands out the points of a win or of two draws
+	n := len(result.Standings)
+	points := 0
+	for _, s := range result.Standings {
+		assert.Equal(t, n-1, s.Won+s.Drawn+s.Lost)
+		points += s.Points
+	}
+	pairings := n * (n - 1) / 2
+	assert.GreaterOrEqual(t, points, 2*PointsDraw*pairings)
+	assert.LessOrEqual(t, points, PointsWin*pairings)
+}
+
+func TestRun_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"single strategy", Config{Strategies: []string{"rock"}, Games: 1, WinningScore: 3, MaxRounds: 10}},
+		{"unknown strategy", Config{Strategies: []string{"rock", "lizard"}, Games: 1, WinningScore: 3, MaxRounds: 10}},
+		{"duplicate strategy", Config{Strategies: []string{"rock", "cycler", "rock"}, Games: 1, WinningScore: 3, MaxRounds: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Run(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		games  simulation.Outcome
+		wantP1 Standing
+		wantP2 Standing
+	}{
+		{
+			name:   "player 1 wins the pairing",
+			games:  simulation.Outcome{Wins: 3, Losses: 2},
+			wantP1: Standing{Points: PointsWin, Won: 1, GamesWon: 3, GamesLost: 2},
+			wantP2: Standing{Lost: 1, GamesWon: 2, GamesLost: 3},
+		},
+		{
+			name:   "player 2 wins the pairing",
+			games:  simulation.Outcome{Wins: 1, Draws: 1, Losses: 3},
+			wantP1: Standing{Lost: 1, GamesWon: 1, GamesLost: 3},
+			wantP2: Standing{Points: PointsWin, Won: 1, GamesWon: 3, GamesLost: 1},
+		},
+		{
+			name:   "drawn pairing",
+			games:  simulation.Outcome{Wins: 2, Losses: 2},
+			wantP1: Standing{Points: PointsDraw, Drawn: 1, GamesWon: 2, GamesLost: 2},
+			wantP2: Standing{Points: PointsDraw, Drawn: 1, GamesWon: 2, GamesLost: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1, p2 := &Standing{}, &Standing{}
+			score(p1, p2, tt.games)
+			assert.Equal(t, tt.wantP1, *p1)
+			assert.Equal(t, tt.wantP2, *p2)
+		})
+	}
+}
+
+func TestResult_WriteCSV(t *testing.T) {
+	result, err := Run(deterministicConfig())
+	assert.NoError(t, err)
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, result.WriteCSV(out))
+	assert.Equal(t, "rank,strategy,points,won,drawn,lost,games_won,games_lost,margin,vs_cycler,vs_debruijn,vs_rock\n"+
+		"1,cycler,6,2,0,0,10,0,10,,1.0000,1.0000\n"+
+		"2,debruijn,3,1,0,1,5,5,0,0.0000,,1.0000\n"+
+		"3,rock,0,0,0,2,0,10,-10,0.0000,0.0000,\n", out.String())
+}
@@ -0,0 +1,89 @@
+// Package training trains learning strategies offline against the built-in strategies.
+package training
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/random"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+// MaxMemory is the largest number of previous rounds a Q-learning state can be made of.
+const MaxMemory = 4
+
+// Config configures the training of a Q-learning table.
+type Config struct {
+	// Opponents are the strategy specs played in turn, one per episode.
+	Opponents []string
+	Episodes  int
+	// Rounds is the number of rounds of an episode.
+	Rounds int
+	// Memory is the number of previous rounds a state is made of.
+	Memory int
+	// Alpha is the learning rate, Gamma the discount of future rewards and Epsilon the exploration
+	// probability.
+	Alpha, Gamma, Epsilon float64
+	Seed                  int64
+}
+
+// Result is a trained table with the rounds won, drawn and lost along the training.
+type Result struct {
+	Table               *strategies.QTable
+	Wins, Draws, Losses int
+}
+
+// Train plays the episodes and returns the learned table.
+func Train(cfg Config) (Result, error) {
+	if err := validate(cfg); err != nil {
+		return Result{}, err
+	}
+	randomizer := random.NewPCG(uint64(cfg.Seed), 0)
+	opponents := make([]model.Strategy, 0, len(cfg.Opponents))
+	for _, spec := range cfg.Opponents {
+		opponent, err := strategies.New(spec, randomizer)
+		if err != nil {
+			return Result{}, err
+		}
+		opponents = append(opponents, opponent)
+	}
+
+	result := Result{Table: strategies.NewQTable(cfg.Memory)}
+	learner := strategies.NewQLearning(randomizer, result.Table)
+	learner.Learn(cfg.Alpha, cfg.Gamma, cfg.Epsilon)
+	for episode := 0; episode < cfg.Episodes; episode++ {
+		opponent := opponents[episode%len(opponents)]
+		learner.Reset()
+		opponent.Reset()
+		for round := 0; round < cfg.Rounds; round++ {
+			own, other := learner.Next(), opponent.Next()
+			learner.Observe(own, other)
+			opponent.Observe(other, own)
+
+			switch {
+			case model.Beats(own, other):
+				result.Wins++
+			case model.Beats(other, own):
+				result.Losses++
+			default:
+				result.Draws++
+			}
+		}
+	}
+	return result, nil
+}
+
+func validate(cfg Config) error {
+	switch {
+	case len(cfg.Opponents) == 0:
+		return errors.New("at least one opponent is required")
+	case cfg.Episodes < 1 || cfg.Rounds < 1:
+		return errors.New("episodes and rounds must be positive")
+	case cfg.Memory < 0 || cfg.Memory > MaxMemory:
+		return fmt.Errorf("memory must be between 0 and %d", MaxMemory)
+	case cfg.Alpha <= 0 || cfg.Alpha > 1 || cfg.Gamma < 0 || cfg.Gamma >= 1 || cfg.Epsilon < 0 || cfg.Epsilon > 1:
+		return errors.New("alpha must be in (0, 1], gamma in [0, 1) and epsilon in [0, 1]")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package training
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yuripiffer/rock-paper-scissors/model"
+	"github.com/yuripiffer/rock-paper-scissors/strategies"
+)
+
+func TestTrain(t *testing.T) {
+	cfg := Config{
+		Opponents: []string{"rock", "cycler"},
+		Episodes:  200,
+		Rounds:    30,
+		Memory:    1,
+		Alpha:     0.2,
+		Gamma:     0.5,
+		Epsilon:   0.1,
+		Seed:      1,
+	}
+	result, err := Train(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 200*30, result.Wins+result.Draws+result.Losses)
+	assert.Greater(t, result.Wins, result.Losses)
+
+	again, err := Train(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, result, again, "the same seed trains the same table")
+
+	// the trained table beats rock from the first round
+	q := strategies.NewQLearning(&model.RandomizerMock{}, result.Table)
+	assert.Equal(t, model.Paper, q.Next())
+}
+
+func TestTrain_errors(t *testing.T) {
+	valid := Config{Opponents: []string{"rock"}, Episodes: 1, Rounds: 1, Alpha: 0.1, Gamma: 0.9, Epsilon: 0.1}
+	tests := []struct {
+		name   string
+		change func(cfg *Config)
+	}{
+		{"no opponents", func(cfg *Config) { cfg.Opponents = nil }},
+		{"unknown opponent", func(cfg *Config) { cfg.Opponents = []string{"lizard"} }},
+		{"no episodes", func(cfg *Config) { cfg.Episodes = 0 }},
+		{"memory too long", func(cfg *Config) { cfg.Memory = MaxMemory + 1 }},
+		{"learning rate out of range", func(cfg *Config) { cfg.Alpha = 0 }},
+		{"discount out of range", func(cfg *Config) { cfg.Gamma = 1 }},
+		{"exploration out of range", func(cfg *Config) { cfg.Epsilon = 2 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.change(&cfg)
+			_, err := Train(cfg)
+			assert.Error(t, err)
+		})
+	}
+}